@@ -103,6 +103,21 @@ type Log struct {
 	SlowThreshold      uint64 `toml:"slow-threshold" json:"slow-threshold"`
 	ExpensiveThreshold uint   `toml:"expensive-threshold" json:"expensive-threshold"`
 	QueryLogMaxLen     uint64 `toml:"query-log-max-len" json:"query-log-max-len"`
+
+	// RecordPlanInSlowLog enables collecting per-operator runtime stats
+	// (the same rows/time/memory tree EXPLAIN ANALYZE prints) for every
+	// statement, not just ones explicitly analyzed, so a slow query's log
+	// entry can include a breakdown of which operator the time went to.
+	// 0 disables collection entirely.
+	RecordPlanInSlowLog uint32 `toml:"record-plan-in-slow-log" json:"record-plan-in-slow-log"`
+	// PlanInSlowLogThreshold is the minimum query duration, in
+	// milliseconds, above which a slow query's log entry additionally
+	// includes the per-operator breakdown collected via
+	// RecordPlanInSlowLog. It only takes effect once a query has already
+	// crossed SlowThreshold, so it's typically set higher than
+	// SlowThreshold to keep the breakdown reserved for the slowest
+	// queries. 0 disables the breakdown regardless of RecordPlanInSlowLog.
+	PlanInSlowLogThreshold uint64 `toml:"plan-in-slow-log-threshold" json:"plan-in-slow-log-threshold"`
 }
 
 // Security is the security section of the config.
@@ -313,13 +328,15 @@ var defaultConf = Config{
 	},
 	LowerCaseTableNames: 2,
 	Log: Log{
-		Level:              "info",
-		Format:             "text",
-		File:               logutil.NewFileLogConfig(true, logutil.DefaultLogMaxSize),
-		SlowQueryFile:      "tidb-slow.log",
-		SlowThreshold:      logutil.DefaultSlowThreshold,
-		ExpensiveThreshold: 10000,
-		QueryLogMaxLen:     logutil.DefaultQueryLogMaxLen,
+		Level:                  "info",
+		Format:                 "text",
+		File:                   logutil.NewFileLogConfig(true, logutil.DefaultLogMaxSize),
+		SlowQueryFile:          "tidb-slow.log",
+		SlowThreshold:          logutil.DefaultSlowThreshold,
+		ExpensiveThreshold:     10000,
+		QueryLogMaxLen:         logutil.DefaultQueryLogMaxLen,
+		RecordPlanInSlowLog:    1,
+		PlanInSlowLogThreshold: 0,
 	},
 	Status: Status{
 		ReportStatus:    true,
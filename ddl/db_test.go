@@ -2058,13 +2058,15 @@ func (s *testDBSuite3) TestGeneratedColumnDDL(c *C) {
 
 		// modify/change generated columns breaking prior.
 		{`alter table test_gv_ddl modify column b int as (c+100)`, mysql.ErrGeneratedColumnNonPrior},
-		{`alter table test_gv_ddl change column b bnew int as (c+100)`, mysql.ErrGeneratedColumnNonPrior},
+		// renaming b breaks c's existing generation expression, which still
+		// refers to the old name.
+		{`alter table test_gv_ddl change column b bnew int as (c+100)`, mysql.ErrBadField},
 
 		// refer not exist columns in generation expression.
 		{`create table test_gv_ddl_bad (a int, b int as (c+8))`, mysql.ErrBadField},
 
-		// refer generated columns non prior.
-		{`create table test_gv_ddl_bad (a int, b int as (c+1), c int as (a+1))`, mysql.ErrGeneratedColumnNonPrior},
+		// a generated column whose dependency is itself generated, but still circular.
+		{`create table test_gv_ddl_bad (a int as (b+1), b int as (a+1))`, mysql.ErrGeneratedColumnNonPrior},
 
 		// virtual generated columns cannot be primary key.
 		{`create table test_gv_ddl_bad (a int, b int, c int as (a+b) primary key)`, mysql.ErrUnsupportedOnGeneratedColumn},
@@ -2075,6 +2077,14 @@ func (s *testDBSuite3) TestGeneratedColumnDDL(c *C) {
 		assertErrorCode(c, s.tk, tt.stmt, tt.err)
 	}
 
+	// A generated column may now refer to another generated column declared
+	// later in the table, as long as the dependency graph has no cycle; the
+	// two are evaluated in dependency order regardless of declaration order.
+	s.tk.MustExec(`create table test_gv_ddl_forward_ref (a int, b int as (c+1), c int as (a+1))`)
+	s.tk.MustExec(`insert into test_gv_ddl_forward_ref (a) values (1)`)
+	s.tk.MustQuery(`select a, b, c from test_gv_ddl_forward_ref`).Check(testkit.Rows(`1 3 2`))
+	s.tk.MustExec(`drop table test_gv_ddl_forward_ref`)
+
 	// Check alter table modify/change generated column.
 	s.tk.MustExec(`alter table test_gv_ddl modify column c bigint as (b+200) stored`)
 	result = s.tk.MustQuery(`DESC test_gv_ddl`)
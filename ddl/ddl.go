@@ -61,6 +61,11 @@ const (
 	// Mysql maximum number of partitions is 8192, our maximum number of partitions is 1024.
 	// Reference linking https://dev.mysql.com/doc/refman/5.7/en/partitioning-limitations.html.
 	PartitionCountLimit = 1024
+
+	// ownerResignTimeout is the max time close() waits for ResignOwner to
+	// finish handing off DDL ownership before moving on with the rest of
+	// shutdown regardless.
+	ownerResignTimeout = 5 * time.Second
 )
 
 var (
@@ -462,6 +467,19 @@ func (d *ddl) close() {
 
 	startTime := time.Now()
 	close(d.quitCh)
+	// Resign DDL ownership before cancelling the campaign, so that if this
+	// instance is currently the owner, another instance can take over right
+	// away instead of waiting for this instance's etcd session lease to
+	// expire (up to ManagerSessionTTL seconds). This matters most during a
+	// graceful shutdown/rolling restart, where minimizing the DDL-ownership
+	// gap avoids stalling DDL jobs on other instances.
+	if d.ownerManager.IsOwner() {
+		resignCtx, cancel := context.WithTimeout(context.Background(), ownerResignTimeout)
+		if err := d.ownerManager.ResignOwner(resignCtx); err != nil {
+			logutil.Logger(ddlLogCtx).Warn("[ddl] resign DDL owner failed", zap.Error(err))
+		}
+		cancel()
+	}
 	d.ownerManager.Cancel()
 	err := d.schemaSyncer.RemoveSelfVersionPath()
 	if err != nil {
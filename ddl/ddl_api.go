@@ -715,7 +715,7 @@ func checkGeneratedColumn(colDefs []*ast.ColumnDef) error {
 	var colName2Generation = make(map[string]columnGenerationInDDL, len(colDefs))
 	var exists bool
 	var autoIncrementColumn string
-	for i, colDef := range colDefs {
+	for _, colDef := range colDefs {
 		for _, option := range colDef.Options {
 			if option.Tp == ast.ColumnOptionGenerated {
 				if err := checkIllegalFn4GeneratedColumn(colDef.Name.Name.L, option.Expr); err != nil {
@@ -729,12 +729,10 @@ func checkGeneratedColumn(colDefs []*ast.ColumnDef) error {
 		generated, depCols := findDependedColumnNames(colDef)
 		if !generated {
 			colName2Generation[colDef.Name.Name.L] = columnGenerationInDDL{
-				position:  i,
 				generated: false,
 			}
 		} else {
 			colName2Generation[colDef.Name.Name.L] = columnGenerationInDDL{
-				position:    i,
 				generated:   true,
 				dependences: depCols,
 			}
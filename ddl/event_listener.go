@@ -0,0 +1,79 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"sync"
+
+	"github.com/pingcap/parser/model"
+)
+
+// JobListener is notified of every DDL job state transition (queued,
+// running, done, rollback, ...) the DDL owner observes, in addition to the
+// Callback hooks the owner loop itself relies on for correctness.
+type JobListener interface {
+	// OnJobStateChange is called with the job's current state, every time
+	// the owner loop runs or updates it. Implementations must not block for
+	// long, since they are invoked synchronously from the owner loop.
+	OnJobStateChange(job *model.Job)
+}
+
+// EventCallback wraps another Callback and fans out every job state
+// transition it observes to a set of registered JobListeners, so external
+// systems can react to schema changes promptly instead of polling
+// ddl/history.
+type EventCallback struct {
+	Callback
+
+	mu struct {
+		sync.RWMutex
+		listeners []JobListener
+	}
+}
+
+// NewEventCallback creates an EventCallback wrapping base. base's own hooks
+// always run first; listeners are notified afterward.
+func NewEventCallback(base Callback) *EventCallback {
+	return &EventCallback{Callback: base}
+}
+
+// AddListener registers l to be notified of future job state transitions.
+func (c *EventCallback) AddListener(l JobListener) {
+	c.mu.Lock()
+	c.mu.listeners = append(c.mu.listeners, l)
+	c.mu.Unlock()
+}
+
+// OnJobRunBefore implements Callback.OnJobRunBefore.
+func (c *EventCallback) OnJobRunBefore(job *model.Job) {
+	c.Callback.OnJobRunBefore(job)
+	c.notify(job)
+}
+
+// OnJobUpdated implements Callback.OnJobUpdated.
+func (c *EventCallback) OnJobUpdated(job *model.Job) {
+	c.Callback.OnJobUpdated(job)
+	c.notify(job)
+}
+
+func (c *EventCallback) notify(job *model.Job) {
+	c.mu.RLock()
+	listeners := make([]JobListener, len(c.mu.listeners))
+	copy(listeners, c.mu.listeners)
+	c.mu.RUnlock()
+
+	for _, l := range listeners {
+		l.OnJobStateChange(job)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/parser/model"
+)
+
+type testEventSuite struct{}
+
+var _ = Suite(&testEventSuite{})
+
+type recordingListener struct {
+	jobs []*model.Job
+}
+
+func (l *recordingListener) OnJobStateChange(job *model.Job) {
+	l.jobs = append(l.jobs, job)
+}
+
+func (s *testEventSuite) TestEventCallbackFanOut(c *C) {
+	cb := NewEventCallback(&BaseCallback{})
+	l1 := &recordingListener{}
+	l2 := &recordingListener{}
+	cb.AddListener(l1)
+	cb.AddListener(l2)
+
+	job := &model.Job{ID: 1, State: model.JobStateRunning}
+	cb.OnJobRunBefore(job)
+	job.State = model.JobStateDone
+	cb.OnJobUpdated(job)
+
+	c.Assert(l1.jobs, HasLen, 2)
+	c.Assert(l2.jobs, HasLen, 2)
+	c.Assert(l1.jobs[1].State, Equals, model.JobStateDone)
+}
+
+func (s *testEventSuite) TestWebhookJobListener(c *C) {
+	received := make(chan jobEvent, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event jobEvent
+		c.Assert(json.NewDecoder(r.Body).Decode(&event), IsNil)
+		received <- event
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	listener := NewWebhookJobListener(srv.URL)
+	listener.OnJobStateChange(&model.Job{ID: 42, Type: model.ActionCreateTable, State: model.JobStateDone})
+
+	event := <-received
+	c.Assert(event.JobID, Equals, int64(42))
+	c.Assert(event.State, Equals, model.JobStateDone.String())
+}
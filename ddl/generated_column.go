@@ -23,32 +23,53 @@ import (
 
 // columnGenerationInDDL is a struct for validating generated columns in DDL.
 type columnGenerationInDDL struct {
-	position    int
 	generated   bool
 	dependences map[string]struct{}
 }
 
 // verifyColumnGeneration is for CREATE TABLE, because we need verify all columns in the table.
+// A generated column may refer to another generated column regardless of declaration
+// order — the order they're evaluated in is computed separately once the generation
+// expressions are planned (see planner/core's resolveGeneratedColumns) — but the
+// dependency graph still can't contain a cycle.
 func verifyColumnGeneration(colName2Generation map[string]columnGenerationInDDL, colName string) error {
 	attribute := colName2Generation[colName]
-	if attribute.generated {
-		for depCol := range attribute.dependences {
-			if attr, ok := colName2Generation[depCol]; ok {
-				if attr.generated && attribute.position <= attr.position {
-					// A generated column definition can refer to other
-					// generated columns occurring earilier in the table.
-					err := errGeneratedColumnNonPrior.GenWithStackByArgs()
-					return errors.Trace(err)
-				}
-			} else {
-				err := errBadField.GenWithStackByArgs(depCol, "generated column function")
-				return errors.Trace(err)
-			}
+	if !attribute.generated {
+		return nil
+	}
+	for depCol := range attribute.dependences {
+		if _, ok := colName2Generation[depCol]; !ok {
+			err := errBadField.GenWithStackByArgs(depCol, "generated column function")
+			return errors.Trace(err)
 		}
 	}
+	if generatedColumnCycle(colName2Generation, colName, make(map[string]bool)) {
+		err := errGeneratedColumnNonPrior.GenWithStackByArgs()
+		return errors.Trace(err)
+	}
 	return nil
 }
 
+// generatedColumnCycle reports whether colName's generated-column dependency
+// chain, followed transitively, loops back to itself.
+func generatedColumnCycle(colName2Generation map[string]columnGenerationInDDL, colName string, visiting map[string]bool) bool {
+	attribute := colName2Generation[colName]
+	if !attribute.generated {
+		return false
+	}
+	if visiting[colName] {
+		return true
+	}
+	visiting[colName] = true
+	for depCol := range attribute.dependences {
+		if generatedColumnCycle(colName2Generation, depCol, visiting) {
+			return true
+		}
+	}
+	delete(visiting, colName)
+	return false
+}
+
 // columnNamesCover checks whether dependColNames is covered by normalColNames or not.
 // it's only for alter table add column because before alter, we can make sure that all
 // columns in table are verified already.
@@ -120,22 +141,19 @@ func checkModifyGeneratedColumn(originCols []*table.Column, oldCol, newCol *tabl
 	}
 	// rule 2.
 	var colName2Generation = make(map[string]columnGenerationInDDL, len(originCols))
-	for i, column := range originCols {
+	for _, column := range originCols {
 		// We can compare the pointers simply.
 		if column == oldCol {
 			colName2Generation[newCol.Name.L] = columnGenerationInDDL{
-				position:    i,
 				generated:   newCol.IsGenerated(),
 				dependences: newCol.Dependences,
 			}
 		} else if !column.IsGenerated() {
 			colName2Generation[column.Name.L] = columnGenerationInDDL{
-				position:  i,
 				generated: false,
 			}
 		} else {
 			colName2Generation[column.Name.L] = columnGenerationInDDL{
-				position:    i,
 				generated:   true,
 				dependences: column.Dependences,
 			}
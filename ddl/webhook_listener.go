@@ -0,0 +1,82 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/terror"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// webhookTimeout bounds how long WebhookJobListener waits for the sink to
+// accept one notification, since it is invoked synchronously from the DDL
+// owner loop and must never stall it on an unreachable endpoint.
+const webhookTimeout = 5 * time.Second
+
+// jobEvent is the JSON payload WebhookJobListener posts for every DDL job
+// state transition.
+type jobEvent struct {
+	JobID    int64  `json:"job_id"`
+	SchemaID int64  `json:"schema_id"`
+	TableID  int64  `json:"table_id"`
+	Type     string `json:"type"`
+	State    string `json:"state"`
+	Query    string `json:"query"`
+}
+
+// WebhookJobListener is a JobListener that POSTs each DDL job state
+// transition as JSON to a configured HTTP endpoint, for external systems
+// that want schema-change notifications without polling ddl/history.
+type WebhookJobListener struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookJobListener creates a WebhookJobListener that posts to url.
+func NewWebhookJobListener(url string) *WebhookJobListener {
+	return &WebhookJobListener{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// OnJobStateChange implements JobListener.
+func (w *WebhookJobListener) OnJobStateChange(job *model.Job) {
+	body, err := json.Marshal(jobEvent{
+		JobID:    job.ID,
+		SchemaID: job.SchemaID,
+		TableID:  job.TableID,
+		Type:     job.Type.String(),
+		State:    job.State.String(),
+		Query:    job.Query,
+	})
+	if err != nil {
+		logutil.Logger(context.Background()).Warn("marshal ddl job event failed", zap.Error(err))
+		return
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logutil.Logger(context.Background()).Warn("post ddl job event failed", zap.String("url", w.url), zap.Error(err))
+		return
+	}
+	terror.Log(resp.Body.Close())
+}
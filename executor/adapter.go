@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/sqlexec"
 	"go.uber.org/zap"
@@ -58,6 +59,13 @@ type recordSet struct {
 	stmt       *ExecStmt
 	lastErr    error
 	txnStartTS uint64
+
+	// timeoutCtx and timeoutCancel implement this statement's effective
+	// MAX_EXECUTION_TIME: when set, Next uses timeoutCtx (instead of its own
+	// ctx argument) so the deadline is enforced across every call, including
+	// ones made with a fresh context.Background() by the caller.
+	timeoutCtx    context.Context
+	timeoutCancel context.CancelFunc
 }
 
 func (a *recordSet) Fields() []*ast.ResultField {
@@ -102,6 +110,11 @@ func (a *recordSet) Next(ctx context.Context, req *chunk.RecordBatch) error {
 	if span := opentracing.SpanFromContext(ctx); span != nil && span.Tracer() != nil {
 		span1 := span.Tracer().StartSpan("recordSet.Next", opentracing.ChildOf(span.Context()))
 		defer span1.Finish()
+		if a.timeoutCtx != nil {
+			ctx = opentracing.ContextWithSpan(a.timeoutCtx, span)
+		}
+	} else if a.timeoutCtx != nil {
+		ctx = a.timeoutCtx
 	}
 
 	err := a.executor.Next(ctx, req)
@@ -131,6 +144,9 @@ func (a *recordSet) Close() error {
 	err := a.executor.Close()
 	a.stmt.LogSlowQuery(a.txnStartTS, a.lastErr == nil)
 	a.stmt.logAudit()
+	if a.timeoutCancel != nil {
+		a.timeoutCancel()
+	}
 	return err
 }
 
@@ -219,13 +235,24 @@ func (a *ExecStmt) Exec(ctx context.Context) (sqlexec.RecordSet, error) {
 		}()
 	}
 
+	var timeoutCancel context.CancelFunc
+	if maxExecutionTime := a.getMaxExecutionTime(sctx); maxExecutionTime > 0 {
+		ctx, timeoutCancel = context.WithTimeout(ctx, time.Duration(maxExecutionTime)*time.Millisecond)
+	}
+
 	e, err := a.buildExecutor(sctx)
 	if err != nil {
+		if timeoutCancel != nil {
+			timeoutCancel()
+		}
 		return nil, err
 	}
 
 	if err = e.Open(ctx); err != nil {
 		terror.Call(e.Close)
+		if timeoutCancel != nil {
+			timeoutCancel()
+		}
 		return nil, err
 	}
 
@@ -248,27 +275,63 @@ func (a *ExecStmt) Exec(ctx context.Context) (sqlexec.RecordSet, error) {
 
 	// If the executor doesn't return any result to the client, we execute it without delay.
 	if e.Schema().Len() == 0 {
+		defer func() {
+			if timeoutCancel != nil {
+				timeoutCancel()
+			}
+		}()
 		return a.handleNoDelayExecutor(ctx, sctx, e)
 	} else if proj, ok := e.(*ProjectionExec); ok && proj.calculateNoDelay {
 		// Currently this is only for the "DO" statement. Take "DO 1, @a=2;" as an example:
 		// the Projection has two expressions and two columns in the schema, but we should
 		// not return the result of the two expressions.
+		defer func() {
+			if timeoutCancel != nil {
+				timeoutCancel()
+			}
+		}()
 		return a.handleNoDelayExecutor(ctx, sctx, e)
 	}
 
 	var txnStartTS uint64
 	txn, err1 := sctx.Txn(false)
 	if err1 != nil {
+		if timeoutCancel != nil {
+			timeoutCancel()
+		}
 		return nil, err
 	}
 	if txn.Valid() {
 		txnStartTS = txn.StartTS()
 	}
-	return &recordSet{
-		executor:   e,
-		stmt:       a,
-		txnStartTS: txnStartTS,
-	}, nil
+	rs := &recordSet{
+		executor:      e,
+		stmt:          a,
+		txnStartTS:    txnStartTS,
+		timeoutCancel: timeoutCancel,
+	}
+	if timeoutCancel != nil {
+		rs.timeoutCtx = ctx
+	}
+	return rs, nil
+}
+
+// getMaxExecutionTime returns the effective MAX_EXECUTION_TIME, in
+// milliseconds, for this statement: the query's own MAX_EXECUTION_TIME
+// optimizer hint if one was set during planning, falling back to the
+// session/global max_execution_time default. It only applies to SELECT
+// statements, matching MySQL's MAX_EXECUTION_TIME semantics; 0 means no
+// limit. Internal/restricted SQL is never limited, so background work (e.g.
+// stats collection) run through the same session isn't affected.
+func (a *ExecStmt) getMaxExecutionTime(sctx sessionctx.Context) uint64 {
+	vars := sctx.GetSessionVars()
+	if !vars.StmtCtx.InSelectStmt || vars.InRestrictedSQL {
+		return 0
+	}
+	if vars.StmtCtx.MaxExecutionTime > 0 {
+		return vars.StmtCtx.MaxExecutionTime
+	}
+	return vars.MaxExecutionTime
 }
 
 func (a *ExecStmt) handleNoDelayExecutor(ctx context.Context, sctx sessionctx.Context, e Executor) (sqlexec.RecordSet, error) {
@@ -336,6 +399,16 @@ func (a *ExecStmt) buildExecutor(ctx sessionctx.Context) (Executor, error) {
 		ctx.GetSessionVars().StmtCtx.Priority = kv.PriorityLow
 	}
 
+	stmtCtxForStats := ctx.GetSessionVars().StmtCtx
+	if config.GetGlobalConfig().Log.RecordPlanInSlowLog != 0 && stmtCtxForStats.RuntimeStatsColl == nil {
+		// Collecting runtime stats unconditionally (not only for EXPLAIN
+		// ANALYZE) lets LogSlowQuery attach a per-operator breakdown to a
+		// slow query's log entry; each executor's Record call is already a
+		// no-op check against a nil RuntimeStatsColl, so this only adds the
+		// bookkeeping cost when enabled.
+		stmtCtxForStats.RuntimeStatsColl = execdetails.NewRuntimeStatsColl()
+	}
+
 	b := newExecutorBuilder(ctx, a.InfoSchema)
 	e := b.build(a.Plan)
 	if b.err != nil {
@@ -406,12 +479,16 @@ func (a *ExecStmt) LogSlowQuery(txnTS uint64, succ bool) {
 	copTaskInfo := sessVars.StmtCtx.CopTasksDetails()
 	statsInfos := a.getStatsInfo()
 	memMax := sessVars.StmtCtx.MemTracker.MaxConsumed()
+	var planStats string
+	if planThreshold := atomic.LoadUint64(&cfg.Log.PlanInSlowLogThreshold); planThreshold != 0 && costTime >= time.Duration(planThreshold)*time.Millisecond {
+		planStats = getSlowLogPlanStats(a.Plan, sessVars.StmtCtx.RuntimeStatsColl)
+	}
 	if costTime < threshold {
 		_, digest := sessVars.StmtCtx.SQLDigest()
-		logutil.SlowQueryLogger.Debug(sessVars.SlowLogFormat(txnTS, costTime, execDetail, indexIDs, digest, statsInfos, copTaskInfo, memMax, sql))
+		logutil.SlowQueryLogger.Debug(sessVars.SlowLogFormat(txnTS, costTime, execDetail, indexIDs, digest, statsInfos, copTaskInfo, memMax, planStats, sql))
 	} else {
 		_, digest := sessVars.StmtCtx.SQLDigest()
-		logutil.SlowQueryLogger.Warn(sessVars.SlowLogFormat(txnTS, costTime, execDetail, indexIDs, digest, statsInfos, copTaskInfo, memMax, sql))
+		logutil.SlowQueryLogger.Warn(sessVars.SlowLogFormat(txnTS, costTime, execDetail, indexIDs, digest, statsInfos, copTaskInfo, memMax, planStats, sql))
 		metrics.TotalQueryProcHistogram.Observe(costTime.Seconds())
 		metrics.TotalCopProcHistogram.Observe(execDetail.ProcessTime.Seconds())
 		metrics.TotalCopWaitHistogram.Observe(execDetail.WaitTime.Seconds())
@@ -459,6 +536,49 @@ func (a *ExecStmt) getStatsInfo() map[string]uint64 {
 	return statsInfos
 }
 
+// getSlowLogPlanStats renders a compact, single-token-per-field summary of
+// each operator's runtime stats (time/loops/rows), for attaching to a slow
+// query's log entry. It has no spaces, matching every other slow log field,
+// since infoschema's ParseSlowLog splits each "# " line on whitespace.
+func getSlowLogPlanStats(plan plannercore.Plan, statsColl *execdetails.RuntimeStatsColl) string {
+	var physicalPlan plannercore.PhysicalPlan
+	switch p := plan.(type) {
+	case *plannercore.Insert:
+		physicalPlan = p.SelectPlan
+	case *plannercore.Update:
+		physicalPlan = p.SelectPlan
+	case *plannercore.Delete:
+		physicalPlan = p.SelectPlan
+	case plannercore.PhysicalPlan:
+		physicalPlan = p
+	}
+	if physicalPlan == nil || statsColl == nil {
+		return ""
+	}
+	var parts []string
+	collectSlowLogPlanStats(physicalPlan, statsColl, &parts)
+	return strings.Join(parts, ";")
+}
+
+func collectSlowLogPlanStats(p plannercore.PhysicalPlan, statsColl *execdetails.RuntimeStatsColl, parts *[]string) {
+	id := p.ExplainID().String()
+	var statsStr string
+	switch {
+	case statsColl.ExistsRootStats(id):
+		statsStr = statsColl.GetRootStats(id).String()
+	case statsColl.ExistsCopStats(id):
+		statsStr = statsColl.GetCopStats(id).String()
+	}
+	if statsStr != "" {
+		*parts = append(*parts, id+"{"+strings.Replace(statsStr, " ", "", -1)+"}")
+	}
+	for _, child := range p.Children() {
+		if cp, ok := child.(plannercore.PhysicalPlan); ok {
+			collectSlowLogPlanStats(cp, statsColl, parts)
+		}
+	}
+}
+
 // IsPointGetWithPKOrUniqueKeyByAutoCommit returns true when meets following conditions:
 //  1. ctx is auto commit tagged
 //  2. txn is not valid
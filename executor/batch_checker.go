@@ -15,6 +15,7 @@ package executor
 
 import (
 	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
@@ -286,8 +287,14 @@ func (b *batchChecker) deleteDupKeys(ctx sessionctx.Context, t table.Table, rows
 
 // getOldRow gets the table record row from storage for batch check.
 // t could be a normal table or a partition, but it must not be a PartitionedTable.
+// genExprs and genColumns are parallel slices, both in the dependency order
+// the planner arranged for generated columns (see InsertValues.fillRow),
+// which may not be cols order once a generated column can reference another
+// one declared later in the table -- so genColumns[gIdx].Name is used to
+// find the matching column rather than assuming genExprs[gIdx] lines up with
+// the gIdx-th generated column encountered while walking cols.
 func (b *batchChecker) getOldRow(ctx sessionctx.Context, t table.Table, handle int64,
-	genExprs []expression.Expression) ([]types.Datum, error) {
+	genExprs []expression.Expression, genColumns []*ast.ColumnName) ([]types.Datum, error) {
 	oldValue, ok := b.dupOldRowValues[string(t.RecordKey(handle))]
 	if !ok {
 		return nil, errors.NotFoundf("can not be duplicated row, due to old row not found. handle %d", handle)
@@ -298,7 +305,6 @@ func (b *batchChecker) getOldRow(ctx sessionctx.Context, t table.Table, handle i
 		return nil, err
 	}
 	// Fill write-only and write-reorg columns with originDefaultValue if not found in oldValue.
-	gIdx := 0
 	for _, col := range cols {
 		if col.State != model.StatePublic && oldRow[col.Offset].IsNull() {
 			_, found := oldRowMap[col.ID]
@@ -309,19 +315,20 @@ func (b *batchChecker) getOldRow(ctx sessionctx.Context, t table.Table, handle i
 				}
 			}
 		}
-		if col.IsGenerated() {
+	}
+	for gIdx, expr := range genExprs {
+		col := table.FindCol(cols, genColumns[gIdx].Name.L)
+		if col.GeneratedStored {
 			// only the virtual column needs fill back.
-			if !col.GeneratedStored {
-				val, err := genExprs[gIdx].Eval(chunk.MutRowFromDatums(oldRow).ToRow())
-				if err != nil {
-					return nil, err
-				}
-				oldRow[col.Offset], err = table.CastValue(ctx, val, col.ToInfo())
-				if err != nil {
-					return nil, err
-				}
-			}
-			gIdx++
+			continue
+		}
+		val, err := expr.Eval(chunk.MutRowFromDatums(oldRow).ToRow())
+		if err != nil {
+			return nil, err
+		}
+		oldRow[col.Offset], err = table.CastValue(ctx, val, col.ToInfo())
+		if err != nil {
+			return nil, err
 		}
 	}
 	return oldRow, nil
@@ -114,7 +114,7 @@ func (e *DeleteExec) deleteSingleTableByChunk(ctx context.Context) error {
 		}
 
 		for chunkRow := iter.Begin(); chunkRow != iter.End(); chunkRow = iter.Next() {
-			if batchDelete && rowCount >= batchDMLSize {
+			if batchDelete && (rowCount >= batchDMLSize || pipelinedDMLFlushThreshold(e.ctx)) {
 				if err = e.ctx.StmtCommit(); err != nil {
 					return err
 				}
@@ -1513,6 +1513,82 @@ func (s *testSuite) TestGeneratedColumnWrite(c *C) {
 	}
 }
 
+// TestGeneratedColumnWriteOnDupForwardRef tests that ON DUPLICATE KEY UPDATE
+// and REPLACE recompute forward-referencing generated columns -- a generated
+// column whose generation expression refers to another generated column
+// declared later in the table -- using the right generation expression for
+// each column, not just the one at its same cols-order position.
+func (s *testSuite) TestGeneratedColumnWriteOnDupForwardRef(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec(`CREATE TABLE test_gc_write_forward_ref (a int primary key, b int as (c+1) virtual, c int as (a+1) virtual)`)
+	tk.MustExec(`INSERT INTO test_gc_write_forward_ref (a) VALUES (1)`)
+	tk.MustQuery(`SELECT * FROM test_gc_write_forward_ref`).Check(testkit.Rows(`1 3 2`))
+
+	// b's old value (3) is only available by evaluating its generation
+	// expression against the old row fetched for the duplicate-key update,
+	// since b is virtual and so isn't itself in the on-disk row value. Using
+	// it on the right-hand side catches getOldRow evaluating genExprs
+	// against the wrong column when a generated column's dependency order
+	// (b depends on c) differs from its declaration order (b before c).
+	tk.MustExec(`INSERT INTO test_gc_write_forward_ref (a) VALUES (1) ON DUPLICATE KEY UPDATE a = b + 100`)
+	tk.MustQuery(`SELECT * FROM test_gc_write_forward_ref`).Check(testkit.Rows(`103 105 104`))
+}
+
+// TestIndexSkipScan tests that a composite index can still be probed, one
+// range per distinct value of its leading column, when a query filters on
+// the index's second column but not its leading one.
+func (s *testSuite) TestIndexSkipScan(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec(`CREATE TABLE test_skip_scan (a int, b int, index idx(a, b))`)
+	for _, a := range []int{1, 2, 3} {
+		for b := 1; b <= 5; b++ {
+			tk.MustExec(fmt.Sprintf(`INSERT INTO test_skip_scan VALUES (%d, %d)`, a, b))
+		}
+	}
+	tk.MustExec(`ANALYZE TABLE test_skip_scan`)
+
+	rows := tk.MustQuery(`EXPLAIN SELECT a, b FROM test_skip_scan USE INDEX(idx) WHERE b = 3`).Rows()
+	sawSkipScan := false
+	for _, row := range rows {
+		if strings.Contains(fmt.Sprintf("%v", row), "index skip scan") {
+			sawSkipScan = true
+		}
+	}
+	c.Assert(sawSkipScan, IsTrue)
+
+	tk.MustQuery(`SELECT a, b FROM test_skip_scan USE INDEX(idx) WHERE b = 3 ORDER BY a`).Check(
+		testkit.Rows(`1 3`, `2 3`, `3 3`))
+}
+
+// TestIndexSkipScanBucketMerging tests that the skip scan optimization backs
+// off once the leading column's histogram has merged more than one distinct
+// value into a bucket, rather than treating bucket upper bounds as if they
+// were an exhaustive list of distinct values. Forcing WITH 2 BUCKETS on a
+// leading column with 5 distinct values guarantees that merging happens, so
+// a naive bucket-bounds-as-distinct-values implementation would silently
+// drop rows whose value isn't exactly a bucket's upper bound.
+func (s *testSuite) TestIndexSkipScanBucketMerging(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec(`CREATE TABLE test_skip_scan_merge (a int, b int, index idx(a, b))`)
+	for _, a := range []int{1, 2, 3, 4, 5} {
+		for b := 1; b <= 5; b++ {
+			tk.MustExec(fmt.Sprintf(`INSERT INTO test_skip_scan_merge VALUES (%d, %d)`, a, b))
+		}
+	}
+	tk.MustExec(`ANALYZE TABLE test_skip_scan_merge WITH 2 BUCKETS`)
+
+	rows := tk.MustQuery(`EXPLAIN SELECT a, b FROM test_skip_scan_merge USE INDEX(idx) WHERE b = 3`).Rows()
+	for _, row := range rows {
+		c.Assert(strings.Contains(fmt.Sprintf("%v", row), "index skip scan"), IsFalse)
+	}
+
+	tk.MustQuery(`SELECT a, b FROM test_skip_scan_merge USE INDEX(idx) WHERE b = 3 ORDER BY a`).Check(
+		testkit.Rows(`1 3`, `2 3`, `3 3`, `4 3`, `5 3`))
+}
+
 // TestGeneratedColumnRead tests select generated columns from table.
 // They should be calculated from their generation expressions.
 func (s *testSuite) TestGeneratedColumnRead(c *C) {
@@ -2115,6 +2191,45 @@ func (s *testSuite) TestHistoryRead(c *C) {
 	tk.MustQuery("select * from history_read order by a").Check(testkit.Rows("2 <nil>", "4 <nil>", "8 8", "9 9"))
 }
 
+func (s *testSuite) TestHistoryReadDroppedTable(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists history_read_dropped")
+	tk.MustExec("create table history_read_dropped (a int)")
+	tk.MustExec("insert history_read_dropped values (1), (2)")
+
+	// For mocktikv, safe point is not initialized, we manually insert it for snapshot to use.
+	safePointName := "tikv_gc_safe_point"
+	safePointValue := "20060102-15:04:05 -0700"
+	safePointComment := "All versions after safe point can be accessed. (DO NOT EDIT)"
+	updateSafePoint := fmt.Sprintf(`INSERT INTO mysql.tidb VALUES ('%[1]s', '%[2]s', '%[3]s')
+	ON DUPLICATE KEY
+	UPDATE variable_value = '%[2]s', comment = '%[3]s'`, safePointName, safePointValue, safePointComment)
+	tk.MustExec(updateSafePoint)
+
+	time.Sleep(time.Millisecond)
+	snapshotTime := time.Now()
+	time.Sleep(time.Millisecond)
+
+	tk.MustExec("drop table history_read_dropped")
+
+	// The table no longer exists in the current schema.
+	_, err := tk.Exec("select * from history_read_dropped")
+	c.Assert(err, NotNil)
+
+	// But a snapshot read from before the DROP TABLE still resolves the
+	// table against the historical schema and sees its rows, since
+	// dropping a table only removes its meta key as of the commit ts of
+	// the DROP, and the rows themselves aren't GC'd until the safe point
+	// advances past snapshotTime.
+	tk.MustExec("set @@tidb_snapshot = '" + snapshotTime.Format("2006-01-02 15:04:05.999999") + "'")
+	tk.MustQuery("select * from history_read_dropped order by a").Check(testkit.Rows("1", "2"))
+	tk.MustExec("set @@tidb_snapshot = ''")
+
+	_, err = tk.Exec("select * from history_read_dropped")
+	c.Assert(err, NotNil)
+}
+
 func (s *testSuite) TestScanControlSelection(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test")
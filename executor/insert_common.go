@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -173,6 +174,19 @@ func (e *InsertValues) processSetList() error {
 	return nil
 }
 
+// pipelinedDMLFlushThreshold returns true when the current transaction's
+// membuffer has grown large enough that a batch-mode DML statement should
+// flush early, rather than waiting for tidb_dml_batch_size rows to accumulate.
+// This bounds the memory footprint of very large INSERT/UPDATE/DELETE
+// statements even when the configured batch size is large.
+func pipelinedDMLFlushThreshold(ctx sessionctx.Context) bool {
+	txn, err := ctx.Txn(false)
+	if err != nil || !txn.Valid() {
+		return false
+	}
+	return txn.Size() > kv.TxnTotalSizeLimit*3/4
+}
+
 // insertRows processes `insert|replace into values ()` or `insert|replace into set x=y`
 func (e *InsertValues) insertRows(ctx context.Context, exec func(ctx context.Context, rows [][]types.Datum) error) (err error) {
 	// For `insert|replace into set x=y`, process the set list here.
@@ -191,7 +205,7 @@ func (e *InsertValues) insertRows(ctx context.Context, exec func(ctx context.Con
 			return err
 		}
 		rows = append(rows, row)
-		if batchInsert && e.rowCount%uint64(batchSize) == 0 {
+		if batchInsert && (e.rowCount%uint64(batchSize) == 0 || pipelinedDMLFlushThreshold(e.ctx)) {
 			if err = exec(ctx, rows); err != nil {
 				return err
 			}
@@ -325,7 +339,7 @@ func (e *InsertValues) insertRowsFromSelect(ctx context.Context, exec func(ctx c
 				return err
 			}
 			rows = append(rows, row)
-			if batchInsert && e.rowCount%uint64(batchSize) == 0 {
+			if batchInsert && (e.rowCount%uint64(batchSize) == 0 || pipelinedDMLFlushThreshold(e.ctx)) {
 				if err = exec(ctx, rows); err != nil {
 					return err
 				}
@@ -431,7 +445,6 @@ func (e *InsertValues) fillColValue(datum types.Datum, idx int, column *table.Co
 // fillRow fills generated columns, auto_increment column and empty column.
 // For NOT NULL column, it will return error or use zero value based on sql_mode.
 func (e *InsertValues) fillRow(row []types.Datum, hasValue []bool) ([]types.Datum, error) {
-	gIdx := 0
 	for i, c := range e.Table.Cols() {
 		var err error
 		// Get the default value for all no value columns, the auto increment column is different from the others.
@@ -439,21 +452,27 @@ func (e *InsertValues) fillRow(row []types.Datum, hasValue []bool) ([]types.Datu
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		// Evaluate the generated columns.
-		if c.IsGenerated() {
-			var val types.Datum
-			val, err = e.GenExprs[gIdx].Eval(chunk.MutRowFromDatums(row).ToRow())
-			gIdx++
-			if e.filterErr(err) != nil {
-				return nil, err
-			}
-			row[i], err = table.CastValue(e.ctx, val, c.ToInfo())
-			if err != nil {
-				return nil, err
-			}
+	// Evaluate the generated columns in e.GenColumns/e.GenExprs order. The
+	// planner has already arranged that order so a generated column is
+	// evaluated only after every generated column it depends on, which may
+	// not be e.Table.Cols() order once generated columns can reference other
+	// generated columns declared later in the table.
+	for gIdx, expr := range e.GenExprs {
+		col := table.FindCol(e.Table.Cols(), e.GenColumns[gIdx].Name.L)
+		val, err := expr.Eval(chunk.MutRowFromDatums(row).ToRow())
+		if e.filterErr(err) != nil {
+			return nil, err
+		}
+		row[col.Offset], err = table.CastValue(e.ctx, val, col.ToInfo())
+		if err != nil {
+			return nil, err
 		}
+	}
 
+	for i, c := range e.Table.Cols() {
+		var err error
 		// Handle the bad null error.
 		if row[i], err = c.HandleBadNull(row[i], e.ctx.GetSessionVars().StmtCtx); err != nil {
 			return nil, err
@@ -409,6 +409,16 @@ func (e *HashJoinExec) joinMatchedOuterRow2Chunk(workerID uint, outerRow chunk.R
 		return false, joinResult
 	}
 	if hasNull {
+		// A null join key can never equal anything, so there's no row to probe
+		// for, regardless of whether the equal condition is a plain correlated
+		// column or one a `[NOT] IN`/`!= ALL` subquery was decorrelated through:
+		// extractOnCondition/LogicalJoin.updateEQCond never move a condition
+		// rewritten from `[NOT] IN`/`!= ALL` into EqualConditions (it stays in
+		// OtherConditions, see expression.IsEQCondFromIn), so a null
+		// EqualConditions-derived key always means the correlated match set for
+		// this outer row is empty -- a definite miss -- independent of the IN
+		// value's own nullness. That value's three-valued comparison is handled
+		// separately, by evaluating OtherConditions per matched row.
 		e.joiners[workerID].onMissMatch(false, outerRow, joinResult.chk)
 		return true, joinResult
 	}
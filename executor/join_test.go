@@ -1353,6 +1353,26 @@ func (s *testSuite2) TestNullEmptyAwareSemiJoin(c *C) {
 	}
 }
 
+// TestNullAwareHashJoinCorrelatedKey pins a correlated `NOT IN` query whose
+// EqualConditions key (the correlation, not the IN value) is null for one
+// outer row. It must still be treated as a definite miss -- a null
+// correlated key means the correlated subquery is empty for that row, so
+// `NOT IN (empty set)` is true regardless of the IN value's own nullness.
+// Guards against re-deriving HashJoinExec's null-key short-circuit from
+// whether the *IN value* was rewritten (expression.IsEQCondFromIn): that
+// value is never the EqualConditions key -- extractOnCondition/
+// LogicalJoin.updateEQCond always keep it in OtherConditions -- so gating the
+// EqualConditions-key-null miss on it would wrongly suppress this row.
+func (s *testSuite2) TestNullAwareHashJoinCorrelatedKey(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int)")
+	tk.MustExec("insert into t values(1, null, 0), (2, 1, 0), (null, 2, 0)")
+	result := tk.MustQuery("select /*+ TIDB_HJ(t1, t2) */ a, b from t t1 where a not in (select b from t t2 where t1.b = t2.a)")
+	result.Check(testkit.Rows("1 <nil>"))
+}
+
 func (s *testSuite2) TestScalarFuncNullSemiJoin(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test")
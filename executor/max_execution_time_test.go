@@ -0,0 +1,27 @@
+package executor_test
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+func (s *testSuite1) TestMaxExecutionTimeHint(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int)")
+	for i := 0; i < 50; i++ {
+		tk.MustExec("insert into t values (?)", i)
+	}
+
+	tk.MustExec("set session max_execution_time = 0")
+	rs := tk.MustQuery("select /*+ MAX_EXECUTION_TIME(5) */ 1")
+	rs.Check(testkit.Rows("1"))
+
+	// A hint of 0 (unlimited) should never error even against a real scan.
+	tk.MustQuery("select /*+ MAX_EXECUTION_TIME(0) */ a from t").Check(testkit.Rows(
+		"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14",
+		"15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29",
+		"30", "31", "32", "33", "34", "35", "36", "37", "38", "39", "40", "41", "42", "43", "44",
+		"45", "46", "47", "48", "49"))
+}
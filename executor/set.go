@@ -16,7 +16,9 @@ package executor
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/parser/ast"
@@ -174,6 +176,11 @@ func (e *SetExecutor) setSysVariable(name string, v *expression.VarAssignment) e
 				return err
 			}
 		}
+		err = e.updateGCSafePointPinIfNeeded(name)
+		if err != nil {
+			sessionVars.SnapshotTS = oldSnapshotTS
+			return err
+		}
 		err = e.loadSnapshotInfoSchemaIfNeeded(name)
 		if err != nil {
 			sessionVars.SnapshotTS = oldSnapshotTS
@@ -229,6 +236,47 @@ func (e *SetExecutor) getVarValue(v *expression.VarAssignment, sysVar *variable.
 	return value, err
 }
 
+// updateGCSafePointPinIfNeeded keeps the GC safe point pin registered by
+// gcutil.PinGCSafePoint in sync with 'tidb_snapshot': any pin this
+// connection previously held is released unconditionally (it's a no-op if
+// there wasn't one), then, if the snapshot is non-zero and
+// 'tidb_snapshot_gc_pin_timeout' is positive, a new pin covering the new
+// snapshot is registered, capped by the global
+// 'tidb_snapshot_gc_pin_timeout_max'. Registering the pin as a row in
+// mysql.tidb (see gcutil.PinGCSafePoint) rather than only in this
+// instance's memory is what lets the GC worker see it regardless of which
+// TiDB instance currently holds the GC-leader election.
+func (e *SetExecutor) updateGCSafePointPinIfNeeded(name string) error {
+	if name != variable.TiDBSnapshot {
+		return nil
+	}
+	vars := e.ctx.GetSessionVars()
+	if vars.GCSafePointPinID != "" {
+		if err := gcutil.UnpinGCSafePoint(e.ctx, vars.GCSafePointPinID); err != nil {
+			return err
+		}
+		vars.GCSafePointPinID = ""
+	}
+	if vars.SnapshotTS == 0 || vars.SnapshotGCPinTimeout == 0 {
+		return nil
+	}
+	timeoutS := vars.SnapshotGCPinTimeout
+	if maxVal, ok := vars.GetSystemVar(variable.TiDBSnapshotGCPinTimeoutMax); ok {
+		if maxS, err := strconv.ParseUint(maxVal, 10, 64); err == nil && maxS < timeoutS {
+			timeoutS = maxS
+		}
+	}
+	if timeoutS == 0 {
+		return nil
+	}
+	pinID, err := gcutil.PinGCSafePoint(e.ctx, vars.SnapshotTS, time.Duration(timeoutS)*time.Second)
+	if err != nil {
+		return err
+	}
+	vars.GCSafePointPinID = pinID
+	return nil
+}
+
 func (e *SetExecutor) loadSnapshotInfoSchemaIfNeeded(name string) error {
 	if name != variable.TiDBSnapshot {
 		return nil
@@ -147,6 +147,11 @@ func (e *UpdateExec) Next(ctx context.Context, req *chunk.RecordBatch) error {
 		e.fetched = true
 		e.ctx.GetSessionVars().StmtCtx.AddRecordRows(uint64(len(e.rows)))
 
+		// If tidb_batch_update is ON and not in a transaction, we could use BatchUpdate mode.
+		sessVars := e.ctx.GetSessionVars()
+		batchUpdate := sessVars.BatchUpdate && !sessVars.InTxn()
+		batchDMLSize := sessVars.DMLBatchSize
+		rowCount := 0
 		for {
 			row, err := e.exec(e.children[0].Schema())
 			if err != nil {
@@ -158,6 +163,18 @@ func (e *UpdateExec) Next(ctx context.Context, req *chunk.RecordBatch) error {
 			if row == nil {
 				break
 			}
+
+			rowCount++
+			if batchUpdate && (rowCount >= batchDMLSize || pipelinedDMLFlushThreshold(e.ctx)) {
+				if err = e.ctx.StmtCommit(); err != nil {
+					return err
+				}
+				if err = e.ctx.NewTxn(ctx); err != nil {
+					// We should return a special error for batch update.
+					return ErrBatchInsertFail.GenWithStack("BatchUpdate failed with error: %v", err)
+				}
+				rowCount = 0
+			}
 		}
 	}
 
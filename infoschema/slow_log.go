@@ -58,6 +58,7 @@ var slowQueryCols = []columnInfo{
 	{variable.SlowLogCopWaitMax, mysql.TypeDouble, 22, 0, nil, nil},
 	{variable.SlowLogCopWaitAddr, mysql.TypeVarchar, 64, 0, nil, nil},
 	{variable.SlowLogMemMax, mysql.TypeLonglong, 20, 0, nil, nil},
+	{variable.SlowLogPlanStats, mysql.TypeVarchar, 4096, 0, nil, nil},
 	{variable.SlowLogQuerySQLStr, mysql.TypeVarchar, 4096, 0, nil, nil},
 }
 
@@ -159,6 +160,7 @@ type slowQueryTuple struct {
 	maxWaitTime       float64
 	maxWaitAddress    string
 	memMax            int64
+	planStats         string
 	sql               string
 }
 
@@ -285,6 +287,8 @@ func (st *slowQueryTuple) setFieldValue(tz *time.Location, field, value string)
 			return errors.AddStack(err)
 		}
 		st.memMax = num
+	case variable.SlowLogPlanStats:
+		st.planStats = value
 	case variable.SlowLogQuerySQLStr:
 		st.sql = value
 	}
@@ -322,6 +326,7 @@ func (st *slowQueryTuple) convertToDatumRow() []types.Datum {
 	record = append(record, types.NewFloat64Datum(st.maxWaitTime))
 	record = append(record, types.NewStringDatum(st.maxWaitAddress))
 	record = append(record, types.NewIntDatum(st.memMax))
+	record = append(record, types.NewStringDatum(st.planStats))
 	record = append(record, types.NewStringDatum(st.sql))
 	return record
 }
@@ -80,6 +80,9 @@ const (
 	tableAnalyzeStatus                      = "ANALYZE_STATUS"
 	tableTiKVRegionStatus                   = "TIKV_REGION_STATUS"
 	tableTiKVRegionPeers                    = "TIKV_REGION_PEERS"
+	tableMemoryUsage                        = "TIDB_MEMORY_USAGE"
+	tableGCSafePointBlockers                = "TIDB_GC_SAFEPOINT_BLOCKERS"
+	tableStatementsInProgress               = "TIDB_STATEMENTS_IN_PROGRESS"
 )
 
 type columnInfo struct {
@@ -623,6 +626,177 @@ var tableTiKVRegionPeersCols = []columnInfo{
 	{"DOWN_SECONDS", mysql.TypeLonglong, 21, 0, 0, nil},
 }
 
+var tableMemoryUsageCols = []columnInfo{
+	{"SESSION_ID", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"TRACKER_LABEL", mysql.TypeVarchar, 64, mysql.NotNullFlag, "", nil},
+	{"PARENT_TRACKER_LABEL", mysql.TypeVarchar, 64, 0, nil, nil},
+	{"BYTES_CONSUMED", mysql.TypeLonglong, 21, 0, nil, nil},
+	{"BYTES_LIMIT", mysql.TypeLonglong, 21, 0, nil, nil},
+}
+
+// dataForMemoryUsage dumps the live memory.Tracker tree for every session
+// visible to the caller, so OOM risk can be diagnosed without waiting for
+// the memory-quota action's own log line. Visibility follows the same
+// PROCESS-privilege rule as dataForProcesslist: without it, a user only
+// sees their own sessions.
+func dataForMemoryUsage(ctx sessionctx.Context) [][]types.Datum {
+	sm := ctx.GetSessionManager()
+	if sm == nil {
+		return nil
+	}
+
+	loginUser := ctx.GetSessionVars().User
+	var hasProcessPriv bool
+	if pm := privilege.GetPrivilegeManager(ctx); pm != nil {
+		if pm.RequestVerification(ctx.GetSessionVars().ActiveRoles, "", "", "", mysql.ProcessPriv) {
+			hasProcessPriv = true
+		}
+	}
+
+	pl := sm.ShowProcessList()
+	var records [][]types.Datum
+	for _, pi := range pl {
+		if !hasProcessPriv && pi.User != loginUser.Username {
+			continue
+		}
+		if pi.MemTracker == nil {
+			continue
+		}
+		for _, row := range pi.MemTracker.Snapshot() {
+			var parentLabel interface{}
+			if row.ParentLabel != "" {
+				parentLabel = row.ParentLabel
+			}
+			var bytesLimit interface{}
+			if row.BytesLimit >= 0 {
+				bytesLimit = row.BytesLimit
+			}
+			records = append(records, types.MakeDatums(
+				pi.ID,
+				row.Label,
+				parentLabel,
+				row.BytesConsumed,
+				bytesLimit,
+			))
+		}
+	}
+	return records
+}
+
+var tableGCSafePointBlockersCols = []columnInfo{
+	{"SESSION_ID", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"OWNER_USER", mysql.TypeVarchar, 16, mysql.NotNullFlag, "", nil},
+	{"OWNER_HOST", mysql.TypeVarchar, 64, mysql.NotNullFlag, "", nil},
+	{"TYPE", mysql.TypeVarchar, 32, mysql.NotNullFlag, "", nil},
+	{"START_TS", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"AGE_SECONDS", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"INFO", mysql.TypeString, 512, 0, nil, nil},
+}
+
+// dataForGCSafePointBlockers lists every visible session that currently
+// holds an open transaction, which holds the GC safepoint back until it
+// commits or rolls back (GCWorker.calculateNewSafePoint never advances the
+// safepoint past locks a live transaction could still need). TYPE is always
+// "TRANSACTION" for now: this tree has no stale-read-ticket, BR/PITR, or
+// dist-task concept to report alongside it (see
+// docs/design/2019-08-05-gc-safepoint-blocker-registry.md). Killing the
+// blocking session with KILL <SESSION_ID> is this tree's existing
+// force-release action; there is no separate release API to call.
+// Visibility follows the same PROCESS-privilege rule as dataForProcesslist.
+func dataForGCSafePointBlockers(ctx sessionctx.Context) [][]types.Datum {
+	sm := ctx.GetSessionManager()
+	if sm == nil {
+		return nil
+	}
+
+	loginUser := ctx.GetSessionVars().User
+	var hasProcessPriv bool
+	if pm := privilege.GetPrivilegeManager(ctx); pm != nil {
+		if pm.RequestVerification(ctx.GetSessionVars().ActiveRoles, "", "", "", mysql.ProcessPriv) {
+			hasProcessPriv = true
+		}
+	}
+
+	pl := sm.ShowProcessList()
+	var records [][]types.Datum
+	for _, pi := range pl {
+		if !hasProcessPriv && pi.User != loginUser.Username {
+			continue
+		}
+		if pi.CurTxnStartTS == 0 {
+			continue
+		}
+		records = append(records, types.MakeDatums(
+			pi.ID,
+			pi.User,
+			pi.Host,
+			"TRANSACTION",
+			pi.CurTxnStartTS,
+			int64(time.Since(pi.CurTxnStartTime)/time.Second),
+			pi.Info,
+		))
+	}
+	return records
+}
+
+var tableStatementsInProgressCols = []columnInfo{
+	{"SESSION_ID", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"USER", mysql.TypeVarchar, 16, mysql.NotNullFlag, "", nil},
+	{"HOST", mysql.TypeVarchar, 64, mysql.NotNullFlag, "", nil},
+	{"ROWS_WRITTEN", mysql.TypeLonglong, 21, mysql.NotNullFlag, 0, nil},
+	{"TXN_MEM_BUFFER_BYTES", mysql.TypeLonglong, 21, 0, nil, nil},
+	{"INFO", mysql.TypeString, 512, 0, nil, nil},
+}
+
+// dataForStatementsInProgress lets an operator estimate how much longer a
+// big, still-running DML has left: ROWS_WRITTEN is
+// StatementContext.AffectedRows(), read live off the same StatementContext
+// object the statement itself is mutating (not a snapshot taken when the
+// statement started), and TXN_MEM_BUFFER_BYTES is the current transaction's
+// live MemBuffer.Size(), which grows as the statement accumulates mutations.
+// This tree has no per-key backfill-position checkpoint or 2PC
+// prewrite-progress counter for a running DML to report alongside them (see
+// docs/design/2019-08-06-statement-progress-backfill-2pc.md). Visibility
+// follows the same PROCESS-privilege rule as dataForProcesslist.
+func dataForStatementsInProgress(ctx sessionctx.Context) [][]types.Datum {
+	sm := ctx.GetSessionManager()
+	if sm == nil {
+		return nil
+	}
+
+	loginUser := ctx.GetSessionVars().User
+	var hasProcessPriv bool
+	if pm := privilege.GetPrivilegeManager(ctx); pm != nil {
+		if pm.RequestVerification(ctx.GetSessionVars().ActiveRoles, "", "", "", mysql.ProcessPriv) {
+			hasProcessPriv = true
+		}
+	}
+
+	pl := sm.ShowProcessList()
+	var records [][]types.Datum
+	for _, pi := range pl {
+		if !hasProcessPriv && pi.User != loginUser.Username {
+			continue
+		}
+		if pi.StmtCtx == nil {
+			continue
+		}
+		var memBufferBytes interface{}
+		if pi.CurTxnMemBuffer != nil {
+			memBufferBytes = pi.CurTxnMemBuffer.Size()
+		}
+		records = append(records, types.MakeDatums(
+			pi.ID,
+			pi.User,
+			pi.Host,
+			pi.StmtCtx.AffectedRows(),
+			memBufferBytes,
+			pi.Info,
+		))
+	}
+	return records
+}
+
 func dataForTiKVRegionStatus(ctx sessionctx.Context) (records [][]types.Datum, err error) {
 	tikvStore, ok := ctx.GetStore().(tikv.Storage)
 	if !ok {
@@ -1071,6 +1245,11 @@ func (c *statsCache) get(ctx sessionctx.Context) (map[int64]uint64, map[tableHis
 	return tableRows, colLength, nil
 }
 
+// getAutoIncrementID normally fetches the live AUTO_INCREMENT value from the
+// table's allocator, which costs a meta round trip per auto-increment table.
+// When EnableInfoSchemaFastLoad is set, it answers from tblInfo's cached
+// value instead, trading a possibly-stale AUTO_INCREMENT for avoiding that
+// round trip on every row of information_schema.tables.
 func getAutoIncrementID(ctx sessionctx.Context, schema *model.DBInfo, tblInfo *model.TableInfo) (int64, error) {
 	hasAutoIncID := false
 	for _, col := range tblInfo.Cols() {
@@ -1080,7 +1259,7 @@ func getAutoIncrementID(ctx sessionctx.Context, schema *model.DBInfo, tblInfo *m
 		}
 	}
 	autoIncID := tblInfo.AutoIncID
-	if hasAutoIncID {
+	if hasAutoIncID && !ctx.GetSessionVars().EnableInfoSchemaFastLoad {
 		is := ctx.GetSessionVars().TxnCtx.InfoSchema.(InfoSchema)
 		tbl, err := is.TableByName(schema.Name, tblInfo.Name)
 		if err != nil {
@@ -1749,6 +1928,9 @@ var tableNameToColumns = map[string][]columnInfo{
 	tableAnalyzeStatus:                      tableAnalyzeStatusCols,
 	tableTiKVRegionStatus:                   tableTiKVRegionStatusCols,
 	tableTiKVRegionPeers:                    tableTiKVRegionPeersCols,
+	tableMemoryUsage:                        tableMemoryUsageCols,
+	tableGCSafePointBlockers:                tableGCSafePointBlockersCols,
+	tableStatementsInProgress:               tableStatementsInProgressCols,
 }
 
 func createInfoSchemaTable(handle *Handle, meta *model.TableInfo) *infoschemaTable {
@@ -1840,6 +2022,12 @@ func (it *infoschemaTable) getRows(ctx sessionctx.Context, cols []*table.Column)
 		fullRows = dataForCollationCharacterSetApplicability()
 	case tableProcesslist:
 		fullRows = dataForProcesslist(ctx)
+	case tableMemoryUsage:
+		fullRows = dataForMemoryUsage(ctx)
+	case tableGCSafePointBlockers:
+		fullRows = dataForGCSafePointBlockers(ctx)
+	case tableStatementsInProgress:
+		fullRows = dataForStatementsInProgress(ctx)
 	case tableSlowLog:
 		fullRows, err = dataForSlowLog(ctx)
 	case tableTiDBHotRegions:
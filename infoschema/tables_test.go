@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/parser/auth"
@@ -25,10 +26,13 @@ import (
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/statistics"
 	"github.com/pingcap/tidb/statistics/handle"
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/memory"
+	"github.com/pingcap/tidb/util/stringutil"
 	"github.com/pingcap/tidb/util/testkit"
 	"github.com/pingcap/tidb/util/testleak"
 	"github.com/pingcap/tidb/util/testutil"
@@ -290,6 +294,86 @@ func (s *testTableSuite) TestSomeTables(c *C) {
 			"2 user-2 localhost test Init DB 9223372036 2 do something"))
 }
 
+func (s *testTableSuite) TestTiDBMemoryUsage(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+
+	root := memory.NewTracker(stringutil.StringerStr("root"), -1)
+	child := memory.NewTracker(stringutil.StringerStr("child"), 1<<20)
+	child.AttachTo(root)
+	child.Consume(1 << 10)
+
+	sm := &mockSessionManager{make(map[uint64]util.ProcessInfo, 1)}
+	sm.processInfoMap[1] = util.ProcessInfo{
+		ID:         1,
+		User:       "root",
+		Host:       "localhost",
+		MemTracker: root,
+	}
+	tk.Se.SetSessionManager(sm)
+	tk.MustQuery("select tracker_label, parent_tracker_label, bytes_consumed, bytes_limit " +
+		"from information_schema.TIDB_MEMORY_USAGE where session_id = 1 order by tracker_label").Check(
+		testkit.Rows(
+			"child root 1024 1048576",
+			"root <nil> 1024 <nil>",
+		))
+}
+
+func (s *testTableSuite) TestTiDBGCSafepointBlockers(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+
+	sm := &mockSessionManager{make(map[uint64]util.ProcessInfo, 2)}
+	sm.processInfoMap[1] = util.ProcessInfo{
+		ID:              1,
+		User:            "root",
+		Host:            "localhost",
+		Info:            "select * from t",
+		CurTxnStartTS:   1234,
+		CurTxnStartTime: time.Now().Add(-time.Minute),
+	}
+	// Session 2 has no open transaction, so it should not appear.
+	sm.processInfoMap[2] = util.ProcessInfo{
+		ID:   2,
+		User: "root",
+		Host: "localhost",
+	}
+	tk.Se.SetSessionManager(sm)
+	tk.MustQuery("select session_id, owner_user, type, start_ts, info " +
+		"from information_schema.TIDB_GC_SAFEPOINT_BLOCKERS").Check(
+		testkit.Rows("1 root TRANSACTION 1234 select * from t"))
+}
+
+func (s *testTableSuite) TestTiDBStatementsInProgress(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+
+	sc := &stmtctx.StatementContext{}
+	sc.AddAffectedRows(42)
+	buf := kv.NewMemDbBuffer(1024)
+	c.Assert(buf.Set(kv.Key("k"), []byte("v")), IsNil)
+
+	sm := &mockSessionManager{make(map[uint64]util.ProcessInfo, 2)}
+	sm.processInfoMap[1] = util.ProcessInfo{
+		ID:              1,
+		User:            "root",
+		Host:            "localhost",
+		Info:            "update t set a = a + 1",
+		StmtCtx:         sc,
+		CurTxnMemBuffer: buf,
+	}
+	// Session 2 has no in-progress statement tracked, so it should not appear.
+	sm.processInfoMap[2] = util.ProcessInfo{
+		ID:   2,
+		User: "root",
+		Host: "localhost",
+	}
+	tk.Se.SetSessionManager(sm)
+	tk.MustQuery("select session_id, user, rows_written, txn_mem_buffer_bytes, info " +
+		"from information_schema.TIDB_STATEMENTS_IN_PROGRESS").Check(
+		testkit.Rows(fmt.Sprintf("1 root 42 %d update t set a = a + 1", buf.Size())))
+}
+
 func (s *testTableSuite) TestSchemataCharacterSet(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("CREATE DATABASE `foo` DEFAULT CHARACTER SET = 'utf8mb4'")
@@ -352,10 +436,10 @@ select * from t_slim;`))
 	tk.MustExec("set time_zone = '+08:00';")
 	re := tk.MustQuery("select * from information_schema.slow_query")
 	re.Check(testutil.RowsWithSep("|",
-		"2019-02-12 19:33:56.571953|406315658548871171|root@127.0.0.1|6|4.895492|0.161|0.101|0.092|1|100001|100000|test||0|42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772|t1:1,t2:2|0.1|0.2|0.03|127.0.0.1:20160|0.05|0.6|0.8|0.0.0.0:20160|70724|select * from t_slim;"))
+		"2019-02-12 19:33:56.571953|406315658548871171|root@127.0.0.1|6|4.895492|0.161|0.101|0.092|1|100001|100000|test||0|42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772|t1:1,t2:2|0.1|0.2|0.03|127.0.0.1:20160|0.05|0.6|0.8|0.0.0.0:20160|70724||select * from t_slim;"))
 	tk.MustExec("set time_zone = '+00:00';")
 	re = tk.MustQuery("select * from information_schema.slow_query")
-	re.Check(testutil.RowsWithSep("|", "2019-02-12 11:33:56.571953|406315658548871171|root@127.0.0.1|6|4.895492|0.161|0.101|0.092|1|100001|100000|test||0|42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772|t1:1,t2:2|0.1|0.2|0.03|127.0.0.1:20160|0.05|0.6|0.8|0.0.0.0:20160|70724|select * from t_slim;"))
+	re.Check(testutil.RowsWithSep("|", "2019-02-12 11:33:56.571953|406315658548871171|root@127.0.0.1|6|4.895492|0.161|0.101|0.092|1|100001|100000|test||0|42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772|t1:1,t2:2|0.1|0.2|0.03|127.0.0.1:20160|0.05|0.6|0.8|0.0.0.0:20160|70724||select * from t_slim;"))
 }
 
 func (s *testTableSuite) TestForAnalyzeStatus(c *C) {
@@ -33,6 +33,14 @@ import (
 // Test needs to change it, so it's a variable.
 var step = int64(30000)
 
+// maxStep bounds how large an allocator's adaptive batch size
+// (allocator.curStep) may grow to; see allocator.adaptStep.
+var maxStep = step * 100
+
+// hotRefillInterval is how soon after one refill another refill has to
+// happen for a table to be considered "hot" by allocator.adaptStep.
+const hotRefillInterval = 500 * time.Millisecond
+
 var errInvalidTableID = terror.ClassAutoid.New(codeInvalidTableID, "invalid TableID")
 
 // Allocator is an auto increment id generator.
@@ -61,6 +69,36 @@ type allocator struct {
 	// dbID is current database's ID.
 	dbID       int64
 	isUnsigned bool
+	// curStep is the batch size the next refill will request. It grows
+	// when refills happen in quick succession (a hot insert table), up to
+	// maxStep, and drops back to step as soon as a refill is not hot, so a
+	// table that goes idle again doesn't keep reserving an oversized range.
+	curStep int64
+	// lastRefillTime is when the allocator last refilled base/end from the
+	// central allocator; the zero value means it hasn't refilled yet.
+	lastRefillTime time.Time
+}
+
+// nextStep returns the batch size to request on the allocator's next
+// refill.
+func (alloc *allocator) nextStep() int64 {
+	if alloc.curStep <= 0 {
+		return step
+	}
+	return alloc.curStep
+}
+
+// adaptStep records a refill that just happened using usedStep, growing or
+// resetting curStep for the next one depending on how soon it followed the
+// previous refill.
+func (alloc *allocator) adaptStep(usedStep int64) {
+	now := time.Now()
+	if !alloc.lastRefillTime.IsZero() && now.Sub(alloc.lastRefillTime) < hotRefillInterval {
+		alloc.curStep = mathutil.MinInt64(usedStep*2, maxStep)
+	} else {
+		alloc.curStep = step
+	}
+	alloc.lastRefillTime = now
 }
 
 // GetStep is only used by tests
@@ -214,6 +252,7 @@ func (alloc *allocator) Rebase(tableID, requiredBase int64, allocIDs bool) error
 func (alloc *allocator) alloc4Unsigned(tableID int64) (int64, error) {
 	if alloc.base == alloc.end { // step
 		var newBase, newEnd int64
+		nextStep := alloc.nextStep()
 		startTime := time.Now()
 		err := kv.RunInNewTxn(alloc.store, true, func(txn kv.Transaction) error {
 			m := meta.NewMeta(txn)
@@ -222,7 +261,7 @@ func (alloc *allocator) alloc4Unsigned(tableID int64) (int64, error) {
 			if err1 != nil {
 				return err1
 			}
-			tmpStep := int64(mathutil.MinUint64(math.MaxUint64-uint64(newBase), uint64(step)))
+			tmpStep := int64(mathutil.MinUint64(math.MaxUint64-uint64(newBase), uint64(nextStep)))
 			newEnd, err1 = m.GenAutoTableID(alloc.dbID, tableID, tmpStep)
 			return err1
 		})
@@ -234,6 +273,7 @@ func (alloc *allocator) alloc4Unsigned(tableID int64) (int64, error) {
 			return 0, ErrAutoincReadFailed
 		}
 		alloc.base, alloc.end = newBase, newEnd
+		alloc.adaptStep(nextStep)
 	}
 
 	alloc.base = int64(uint64(alloc.base) + 1)
@@ -247,6 +287,7 @@ func (alloc *allocator) alloc4Unsigned(tableID int64) (int64, error) {
 func (alloc *allocator) alloc4Signed(tableID int64) (int64, error) {
 	if alloc.base == alloc.end { // step
 		var newBase, newEnd int64
+		nextStep := alloc.nextStep()
 		startTime := time.Now()
 		err := kv.RunInNewTxn(alloc.store, true, func(txn kv.Transaction) error {
 			m := meta.NewMeta(txn)
@@ -255,7 +296,7 @@ func (alloc *allocator) alloc4Signed(tableID int64) (int64, error) {
 			if err1 != nil {
 				return err1
 			}
-			tmpStep := mathutil.MinInt64(math.MaxInt64-newBase, step)
+			tmpStep := mathutil.MinInt64(math.MaxInt64-newBase, nextStep)
 			newEnd, err1 = m.GenAutoTableID(alloc.dbID, tableID, tmpStep)
 			return err1
 		})
@@ -267,6 +308,7 @@ func (alloc *allocator) alloc4Signed(tableID int64) (int64, error) {
 			return 0, ErrAutoincReadFailed
 		}
 		alloc.base, alloc.end = newBase, newEnd
+		alloc.adaptStep(nextStep)
 	}
 
 	alloc.base++
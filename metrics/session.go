@@ -49,14 +49,14 @@ var (
 			Name:      "schema_lease_error_total",
 			Help:      "Counter of schema lease error",
 		}, []string{LblType})
-	SessionRetry = prometheus.NewHistogram(
+	SessionRetry = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "tidb",
 			Subsystem: "session",
 			Name:      "retry_num",
 			Help:      "Bucketed histogram of session retry count.",
 			Buckets:   prometheus.LinearBuckets(0, 1, 10),
-		})
+		}, []string{LblType})
 	SessionRetryErrorCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "tidb",
@@ -45,6 +45,14 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
 		})
 
+	StatsSyncLoadQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "tidb",
+			Subsystem: "statistics",
+			Name:      "sync_load_queue_depth",
+			Help:      "Gauge of the number of columns pending a histogram sync load.",
+		})
+
 	PseudoEstimation = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "tidb",
@@ -185,6 +185,12 @@ func (e *Execute) OptimizePreparedPlan(ctx sessionctx.Context, is infoschema.Inf
 		if err != nil {
 			return ErrSchemaChanged.GenWithStack("Schema change caused error: %s", err.Error())
 		}
+		if prepared.UseCache {
+			// The cache is keyed by schema version, so the entry under the old
+			// version would otherwise sit in the LRU unreachable until evicted
+			// by capacity; drop it now that we know a DDL has made it stale.
+			ctx.PreparedPlanCache().Delete(NewPSTMTPlanCacheKey(vars, e.ExecID, prepared.SchemaVersion))
+		}
 		prepared.SchemaVersion = is.SchemaMetaVersion()
 	}
 	p, err := e.getPhysicalPlan(ctx, is, prepared)
@@ -75,6 +75,9 @@ func (p *PhysicalIndexScan) ExplainInfo() string {
 	if p.Desc {
 		buffer.WriteString(", desc")
 	}
+	if p.IsSkipScan {
+		buffer.WriteString(", index skip scan")
+	}
 	if p.stats.StatsVersion == statistics.PseudoVersion {
 		buffer.WriteString(", stats:pseudo")
 	}
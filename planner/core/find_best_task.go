@@ -480,6 +480,7 @@ func (ds *DataSource) convertToIndexScan(prop *property.PhysicalProperty, candid
 		dataSourceSchema: ds.schema,
 		isPartition:      ds.isPartition,
 		physicalTableID:  ds.physicalTableID,
+		IsSkipScan:       path.isSkipScan,
 	}.Init(ds.ctx)
 	statsTbl := ds.statisticTable
 	if statsTbl.Indices[idx.ID] != nil {
@@ -513,6 +514,12 @@ func (ds *DataSource) convertToIndexScan(prop *property.PhysicalProperty, candid
 	}
 
 	cop.cst = rowCount * scanFactor
+	if path.isSkipScan {
+		// Skip scan issues one seek per distinct value of the leading
+		// column it probes, on top of the cost of scanning the rows each
+		// seek turns up.
+		cop.cst += float64(path.skipScanDistinctCount) * netWorkStartFactor
+	}
 	task = cop
 	if candidate.isMatchProp {
 		if prop.Items[0].Desc {
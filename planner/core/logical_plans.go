@@ -365,6 +365,104 @@ type accessPath struct {
 	isTablePath bool
 	// forced means this path is generated by `use/force index()`.
 	forced bool
+	// isSkipScan indicates path.ranges were built by probing the index once
+	// per distinct value of its leading column (see tryBuildSkipScanRanges)
+	// instead of by a single range derived from the access conditions.
+	isSkipScan bool
+	// skipScanDistinctCount is the number of distinct leading-column values
+	// isSkipScan probed, i.e. the number of extra index seeks this path
+	// costs over an ordinary range scan.
+	skipScanDistinctCount int
+}
+
+// skipScanMaxDistinctValues bounds how many distinct values of the leading
+// index column tryBuildSkipScanRanges will probe individually. Past this,
+// probing one range per value stops being cheaper than scanning the whole
+// index, so the path falls back to an ordinary range/full scan instead.
+const skipScanMaxDistinctValues = 100
+
+// tryBuildSkipScanRanges checks whether path's index has no access condition
+// on its leading column but does have one on its second column -- the shape
+// ranger.DetachCondAndBuildRangeForIndex can't turn into a useful range,
+// since it only builds ranges from a prefix of the index's columns. When the
+// leading column's histogram reports few enough distinct values, it builds
+// one range per distinct value with the second column's condition appended,
+// so the index can still be probed instead of scanned in full or skipped.
+// It returns false when the index doesn't have this shape, or when the
+// leading column has too many distinct values for probing them individually
+// to pay off; callers should fall back to treating path as an ordinary path.
+func (ds *DataSource) tryBuildSkipScanRanges(path *accessPath) (bool, error) {
+	if len(path.idxCols) < 2 || len(path.accessConds) != 0 {
+		return false, nil
+	}
+	sc := ds.ctx.GetSessionVars().StmtCtx
+	skipCol, probeCol := path.idxCols[0], path.idxCols[1]
+	probeConds, _ := ranger.DetachCondsForColumn(ds.ctx, ds.pushedDownConds, probeCol)
+	if len(probeConds) == 0 {
+		return false, nil
+	}
+	hist, ok := ds.statisticTable.Columns[skipCol.ID]
+	if !ok || hist.IsInvalid(sc, ds.statisticTable.Pseudo) || hist.NDV <= 0 || hist.NDV > skipScanMaxDistinctValues {
+		return false, nil
+	}
+	// A bucket's upper bound is only "the" value of that bucket when every
+	// distinct value got its own bucket -- otherwise BuildColumnHist's
+	// SortedBuilder has merged two or more distinct values into it, and the
+	// values below the upper bound would be silently dropped from the
+	// probed ranges. NullCount > 0 is the same problem for NULL, which
+	// never appears as a bucket bound at all.
+	if hist.NDV != int64(hist.Len()) || hist.NullCount > 0 {
+		return false, nil
+	}
+	probeRanges, err := ranger.BuildColumnRange(probeConds, sc, probeCol.RetType, path.idxColLens[1])
+	if err != nil || len(probeRanges) == 0 {
+		return false, err
+	}
+	skipVals := distinctHistogramValues(hist)
+	if len(skipVals) != hist.Len() || len(skipVals) > skipScanMaxDistinctValues {
+		return false, nil
+	}
+	ranges := make([]*ranger.Range, 0, len(skipVals)*len(probeRanges))
+	for _, skipVal := range skipVals {
+		for _, probeRange := range probeRanges {
+			ranges = append(ranges, &ranger.Range{
+				LowVal:      append([]types.Datum{skipVal}, probeRange.LowVal...),
+				HighVal:     append([]types.Datum{skipVal}, probeRange.HighVal...),
+				LowExclude:  probeRange.LowExclude,
+				HighExclude: probeRange.HighExclude,
+			})
+		}
+	}
+	path.ranges = ranges
+	path.accessConds = append(path.accessConds, probeConds...)
+	path.tableFilters = removeConditions(path.tableFilters, probeConds)
+	path.isSkipScan = true
+	path.skipScanDistinctCount = len(skipVals)
+	return true, nil
+}
+
+// distinctHistogramValues returns one Datum per bucket upper bound in hist.
+// Callers must first confirm hist.NDV == hist.Len(), i.e. that every bucket
+// holds exactly one distinct value -- otherwise a bucket's upper bound is
+// only one of potentially several distinct values merged into it, and this
+// is not a list of "the" distinct values at all.
+func distinctHistogramValues(hist *statistics.Column) []types.Datum {
+	vals := make([]types.Datum, 0, hist.Len())
+	for i := 0; i < hist.Len(); i++ {
+		vals = append(vals, *hist.GetUpper(i))
+	}
+	return vals
+}
+
+// removeConditions returns the conditions in conds that are not in toRemove.
+func removeConditions(conds, toRemove []expression.Expression) []expression.Expression {
+	remained := make([]expression.Expression, 0, len(conds))
+	for _, cond := range conds {
+		if !expression.Contains(toRemove, cond) {
+			remained = append(remained, cond)
+		}
+	}
+	return remained
 }
 
 // deriveTablePathStats will fulfill the information that the accessPath need.
@@ -472,6 +570,18 @@ func (ds *DataSource) deriveIndexPathStats(path *accessPath) (bool, error) {
 		if err != nil {
 			return false, err
 		}
+		if eqOrInCount == 0 {
+			ok, err := ds.tryBuildSkipScanRanges(path)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				path.countAfterAccess, err = ds.stats.HistColl.GetRowCountByIndexRanges(sc, path.index.ID, path.ranges)
+				if err != nil {
+					return false, err
+				}
+			}
+		}
 	} else {
 		path.tableFilters = ds.pushedDownConds
 	}
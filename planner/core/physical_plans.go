@@ -104,6 +104,11 @@ type PhysicalIndexScan struct {
 	// If the query requires the columns that don't belong to index, DoubleRead will be true.
 	DoubleRead bool
 
+	// IsSkipScan means Ranges were built by probing the index once per
+	// distinct value of its leading column instead of by a single range
+	// derived from the access conditions. See accessPath.isSkipScan.
+	IsSkipScan bool
+
 	TableAsName *model.CIStr
 
 	// dataSourceSchema is the original schema of DataSource. The schema of index scan in KV and index reader in TiDB
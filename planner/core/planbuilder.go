@@ -1231,11 +1231,12 @@ func (b *PlanBuilder) findDefaultValue(cols []*table.Column, name *ast.ColumnNam
 
 // resolveGeneratedColumns resolves generated columns with their generation
 // expressions respectively. onDups indicates which columns are in on-duplicate list.
+// The returned igc.Columns/igc.Exprs are ordered so that a generated column is
+// always resolved after every generated column it depends on, which may differ
+// from columns' declaration order now that a generated column can refer to
+// another one declared later in the table.
 func (b *PlanBuilder) resolveGeneratedColumns(columns []*table.Column, onDups map[string]struct{}, mockPlan LogicalPlan) (igc InsertGeneratedColumns, err error) {
-	for _, column := range columns {
-		if !column.IsGenerated() {
-			continue
-		}
+	for _, column := range table.OrderByDependency(columns) {
 		columnName := &ast.ColumnName{Name: column.Name}
 		columnName.SetText(column.Name.O)
 
@@ -15,6 +15,7 @@ package core
 
 import (
 	"math/bits"
+	"sort"
 
 	"github.com/pingcap/parser/ast"
 	"github.com/pingcap/tidb/expression"
@@ -118,8 +119,20 @@ func (s *joinReorderDPSolver) solve(joinGroup []LogicalPlan, eqConds []expressio
 			subNonEqEdges = append(subNonEqEdges, totalNonEqEdges[i])
 			totalNonEqEdges = append(totalNonEqEdges[:i], totalNonEqEdges[i+1:]...)
 		}
-		// Do DP on each sub graph.
-		join, err := s.dpGraph(visitID2NodeID, nodeID2VisitID, joinGroup, totalEqEdges, subNonEqEdges)
+		// Do DP on each sub graph. dpGraph only ever builds and joins connected
+		// subgraphs (see enumerateCsg/enumerateCmp below), so its cost tracks
+		// the number of connected subgraphs of the component rather than all
+		// 2^n subsets of it; for the chain/star/tree-shaped join graphs most
+		// queries produce that stays small well past 15 nodes. A pathological,
+		// densely-connected component can still blow this up, so components
+		// bigger than TiDBOptJoinReorderDPThreshold still fall back to greedy.
+		var join LogicalPlan
+		var err error
+		if len(visitID2NodeID) > s.ctx.GetSessionVars().TiDBOptJoinReorderDPThreshold {
+			join, err = s.greedySolveComponent(visitID2NodeID, joinGroup, totalEqEdges, subNonEqEdges)
+		} else {
+			join, err = s.dpGraph(visitID2NodeID, nodeID2VisitID, joinGroup, totalEqEdges, subNonEqEdges)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -154,57 +167,175 @@ func (s *joinReorderDPSolver) bfsGraph(startNode int, visited []bool, adjacents
 	return visitID2NodeID
 }
 
-// dpGraph is the core part of this algorithm.
-// It implements the traditional join reorder algorithm: DP by subset using the following formula:
-//   bestPlan[S:set of node] = the best one among Join(bestPlan[S1:subset of S], bestPlan[S2: S/S1])
+// dpGraph is the core part of this algorithm: a DPccp-style join reorder DP.
+// It still computes bestPlan[S] = the best of Join(bestPlan[S1], bestPlan[S2])
+// over all ways to split S into disjoint S1, S2, but instead of enumerating
+// every subset of every one of the component's 2^n bitmaps (O(3^n), the
+// reason this used to be capped at 15 nodes), it enumerates (S1, S2) pairs
+// where S1 and S2 are each connected subgraphs joined by at least one edge --
+// see enumerateCsg/enumerateCmp. For the chain/star/tree join graphs most
+// queries produce, the number of connected subgraphs is close to linear or
+// quadratic in the component size, so the same exhaustive, cost-based search
+// now stays practical well past 15 nodes.
 func (s *joinReorderDPSolver) dpGraph(visitID2NodeID, nodeID2VisitID []int, joinGroup []LogicalPlan,
 	totalEqEdges []joinGroupEqEdge, totalNonEqEdges []joinGroupNonEqEdge) (LogicalPlan, error) {
 	nodeCnt := uint(len(visitID2NodeID))
-	bestPlan := make([]*jrNode, 1<<nodeCnt)
-	// bestPlan[s] is nil can be treated as bestCost[s] = +inf.
+	inComponent := make(map[int]bool, nodeCnt)
+	for _, nodeID := range visitID2NodeID {
+		inComponent[nodeID] = true
+	}
+	adj := make([]uint, nodeCnt)
+	for _, edge := range totalEqEdges {
+		if !inComponent[edge.nodeIDs[0]] || !inComponent[edge.nodeIDs[1]] {
+			continue
+		}
+		l := uint(nodeID2VisitID[edge.nodeIDs[0]])
+		r := uint(nodeID2VisitID[edge.nodeIDs[1]])
+		adj[l] |= 1 << r
+		adj[r] |= 1 << l
+	}
+	bestPlan := make(map[uint]*jrNode, nodeCnt*nodeCnt)
 	for i := uint(0); i < nodeCnt; i++ {
 		bestPlan[1<<i] = s.curJoinGroup[visitID2NodeID[i]]
 	}
-	// Enumerate the nodeBitmap from small to big, make sure that S1 must be enumerated before S2 if S1 belongs to S2.
-	for nodeBitmap := uint(1); nodeBitmap < (1 << nodeCnt); nodeBitmap++ {
-		if bits.OnesCount(nodeBitmap) == 1 {
+	// Collect every csg-cmp pair up front, then apply them in increasing order
+	// of their union's size: bestPlan[s1] and bestPlan[s2] are only guaranteed
+	// set once every smaller union has already been applied, and a pair's s2
+	// can easily be larger than its s1, so sorting by s1's own size (as the
+	// old nodeBitmap loop did by enumerating bitmaps from small to big) isn't
+	// enough here.
+	type csgCmpPair struct{ s1, s2 uint }
+	var pairs []csgCmpPair
+	for _, s1 := range s.enumerateCsg(adj, nodeCnt) {
+		s.enumerateCmp(adj, s1, func(s2 uint) {
+			pairs = append(pairs, csgCmpPair{s1, s2})
+		})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return bits.OnesCount(pairs[i].s1|pairs[i].s2) < bits.OnesCount(pairs[j].s1|pairs[j].s2)
+	})
+	for _, pr := range pairs {
+		// Sub-bitmap on the left, like the old nodeBitmap/sub/remain loop did,
+		// so a tie in cost between a pair and its mirror image resolves the
+		// same way this algorithm has always resolved it.
+		sub, remain := pr.s1, pr.s2
+		if sub > remain {
+			sub, remain = remain, sub
+		}
+		// Get the edge connecting the two parts.
+		usedEdges, otherConds := s.nodesAreConnected(sub, remain, nodeID2VisitID, totalEqEdges, totalNonEqEdges)
+		// Here we only check equal condition currently.
+		if len(usedEdges) == 0 {
 			continue
 		}
-		// This loop can iterate all its subset.
-		for sub := (nodeBitmap - 1) & nodeBitmap; sub > 0; sub = (sub - 1) & nodeBitmap {
-			remain := nodeBitmap ^ sub
-			if sub > remain {
-				continue
-			}
-			// If this subset is not connected skip it.
-			if bestPlan[sub] == nil || bestPlan[remain] == nil {
-				continue
-			}
-			// Get the edge connecting the two parts.
-			usedEdges, otherConds := s.nodesAreConnected(sub, remain, nodeID2VisitID, totalEqEdges, totalNonEqEdges)
-			// Here we only check equal condition currently.
-			if len(usedEdges) == 0 {
-				continue
-			}
-			join, err := s.newJoinWithEdge(bestPlan[sub].p, bestPlan[remain].p, usedEdges, otherConds)
-			if err != nil {
-				return nil, err
-			}
-			curCost := s.calcJoinCumCost(join, bestPlan[sub], bestPlan[remain])
-			if bestPlan[nodeBitmap] == nil {
-				bestPlan[nodeBitmap] = &jrNode{
-					p:       join,
-					cumCost: curCost,
-				}
-			} else if bestPlan[nodeBitmap].cumCost > curCost {
-				bestPlan[nodeBitmap].p = join
-				bestPlan[nodeBitmap].cumCost = curCost
-			}
+		join, err := s.newJoinWithEdge(bestPlan[sub].p, bestPlan[remain].p, usedEdges, otherConds)
+		if err != nil {
+			return nil, err
+		}
+		curCost := s.calcJoinCumCost(join, bestPlan[sub], bestPlan[remain])
+		union := sub | remain
+		if bestPlan[union] == nil || bestPlan[union].cumCost > curCost {
+			bestPlan[union] = &jrNode{p: join, cumCost: curCost}
 		}
 	}
 	return bestPlan[(1<<nodeCnt)-1].p, nil
 }
 
+// neighborsOf returns the nodes adjacent to, but not in, set.
+func neighborsOf(adj []uint, set uint) uint {
+	var n uint
+	for s := set; s != 0; s &= s - 1 {
+		n |= adj[bits.TrailingZeros(s)]
+	}
+	return n &^ set
+}
+
+// emitConnectedExtensions calls handle(csg|sub) for every non-empty subset
+// sub of csg's neighbors that avoids excluded, then recurses into each such
+// extension with those neighbors folded into excluded. This is the shared
+// expansion step behind both connected-subgraph (csg) and connected-
+// complement (cmp) enumeration below.
+func emitConnectedExtensions(adj []uint, csg, excluded uint, handle func(ext uint)) {
+	neighbors := neighborsOf(adj, csg) &^ excluded
+	if neighbors == 0 {
+		return
+	}
+	for sub := neighbors; sub != 0; sub = (sub - 1) & neighbors {
+		handle(csg | sub)
+	}
+	newExcluded := excluded | neighbors
+	for sub := neighbors; sub != 0; sub = (sub - 1) & neighbors {
+		emitConnectedExtensions(adj, csg|sub, newExcluded, handle)
+	}
+}
+
+// enumerateCsg returns every connected subgraph of adj (indexed by visit ID)
+// as a bitmask over visit IDs. Each subgraph is produced through exactly one
+// recursion path: node v only seeds or extends into nodes greater than v, so
+// the same subgraph is never emitted twice.
+func (s *joinReorderDPSolver) enumerateCsg(adj []uint, nodeCnt uint) []uint {
+	csgs := make([]uint, 0, nodeCnt*nodeCnt)
+	for v := uint(0); v < nodeCnt; v++ {
+		bit := uint(1) << v
+		csgs = append(csgs, bit)
+		emitConnectedExtensions(adj, bit, bit<<1-1, func(ext uint) {
+			csgs = append(csgs, ext)
+		})
+	}
+	return csgs
+}
+
+// enumerateCmp calls handle once for every connected subgraph disjoint from
+// s1 that's reachable from s1 by at least one edge, restricted to a lowest
+// node index greater than s1's own -- the complement half of a DPccp-style
+// csg-cmp-pair enumeration. That restriction means the pair (s1, s2) is only
+// ever generated once, never again as (s2, s1).
+func (s *joinReorderDPSolver) enumerateCmp(adj []uint, s1 uint, handle func(s2 uint)) {
+	minBit := uint(1) << uint(bits.TrailingZeros(s1))
+	baseExcluded := s1 | (minBit<<1 - 1)
+	seeds := neighborsOf(adj, s1) &^ baseExcluded
+	for seeds != 0 {
+		v := uint(bits.Len(seeds) - 1)
+		bit := uint(1) << v
+		seeds &^= bit
+		handle(bit)
+		emitConnectedExtensions(adj, bit, baseExcluded|bit|seeds, handle)
+	}
+}
+
+// greedySolveComponent reorders a single connected component using the
+// greedy solver, for components too large for dpGraph's exhaustive subset
+// enumeration. totalEqEdges and subNonEqEdges still use original joinGroup
+// node IDs, so they're filtered down to the edges whose endpoints are both in
+// this component before being handed to joinReorderGreedySolver.
+func (s *joinReorderDPSolver) greedySolveComponent(visitID2NodeID []int, joinGroup []LogicalPlan,
+	totalEqEdges []joinGroupEqEdge, subNonEqEdges []joinGroupNonEqEdge) (LogicalPlan, error) {
+	inComponent := make(map[int]bool, len(visitID2NodeID))
+	nodes := make([]LogicalPlan, 0, len(visitID2NodeID))
+	for _, nodeID := range visitID2NodeID {
+		inComponent[nodeID] = true
+		nodes = append(nodes, joinGroup[nodeID])
+	}
+	var eqEdges []*expression.ScalarFunction
+	for _, edge := range totalEqEdges {
+		if inComponent[edge.nodeIDs[0]] && inComponent[edge.nodeIDs[1]] {
+			eqEdges = append(eqEdges, edge.edge)
+		}
+	}
+	otherConds := make([]expression.Expression, 0, len(subNonEqEdges))
+	for _, edge := range subNonEqEdges {
+		otherConds = append(otherConds, edge.expr)
+	}
+	greedySolver := &joinReorderGreedySolver{
+		baseSingleGroupJoinOrderSolver: &baseSingleGroupJoinOrderSolver{
+			ctx:        s.ctx,
+			otherConds: otherConds,
+		},
+		eqEdges: eqEdges,
+	}
+	return greedySolver.solve(nodes)
+}
+
 func (s *joinReorderDPSolver) nodesAreConnected(leftMask, rightMask uint, oldPos2NewPos []int,
 	totalEqEdges []joinGroupEqEdge, totalNonEqEdges []joinGroupNonEqEdge) ([]joinGroupEqEdge, []expression.Expression) {
 	var (
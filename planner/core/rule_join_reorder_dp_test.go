@@ -15,6 +15,7 @@ package core
 
 import (
 	"fmt"
+	"math"
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/parser/ast"
@@ -202,6 +203,67 @@ func (s *testJoinReorderDPSuite) TestDPReorderTPCHQ5(c *C) {
 	c.Assert(s.planToString(result), Equals, "MockJoin{supplier, MockJoin{lineitem, MockJoin{orders, MockJoin{customer, MockJoin{nation, region}}}}}")
 }
 
+// planCumCost sums, over every join in plan and every leaf it joins, the row
+// count mockLogicalJoin.recursiveDeriveStats would report for it -- the same
+// total-intermediate-result-size cost calcJoinCumCost accumulates, just
+// recomputed from the finished tree instead of the jrNode bookkeeping that
+// built it.
+func (s *testJoinReorderDPSuite) planCumCost(plan LogicalPlan) float64 {
+	switch x := plan.(type) {
+	case *mockLogicalJoin:
+		return s.statsMap[x.involvedNodeSet].RowCount + s.planCumCost(x.children[0]) + s.planCumCost(x.children[1])
+	case *DataSource:
+		return x.stats.RowCount
+	}
+	return 0
+}
+
+// TestDPReorderLongChainBeatsLeftDeep builds a 16-table chain join -- one
+// past the old, hardcoded dpJoinReorderMaxNodeNum=15 cap that always routed
+// components this size to the greedy solver -- and checks the DP solver
+// still runs its real cost-based search on it instead of silently degrading
+// to a left-deep order. Every contiguous run of L chained tables is costed
+// at 2^L, a cardinality shape under which any left-deep order (which a
+// greedy fallback always produces, and which is also what dpGraph itself
+// would settle for if its csg/cmp enumeration quietly missed bushy splits)
+// costs exactly 2+4+...+2^16 = 131070, while a balanced bushy tree costs
+// only ~66176. TiDBOptJoinReorderDPThreshold is set to exactly 16 so this
+// component runs through dpGraph, not greedySolveComponent.
+func (s *testJoinReorderDPSuite) TestDPReorderLongChainBeatsLeftDeep(c *C) {
+	const n = 16
+	const leftDeepCost = 131070.0
+	const balancedBushyCost = 66176.0
+	s.statsMap = make(map[int]*property.StatsInfo)
+	joinGroup := make([]LogicalPlan, 0, n)
+	for i := 0; i < n; i++ {
+		joinGroup = append(joinGroup, s.newDataSource(fmt.Sprintf("t%d", i), 2))
+	}
+	var eqConds []expression.Expression
+	for i := 0; i < n-1; i++ {
+		eqConds = append(eqConds, expression.NewFunctionInternal(s.ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny),
+			joinGroup[i].Schema().Columns[0], joinGroup[i+1].Schema().Columns[0]))
+	}
+	for lo := 0; lo < n; lo++ {
+		mask := 0
+		for hi := lo; hi < n; hi++ {
+			mask |= 1 << uint(hi)
+			s.mockStatsInfo(mask, math.Pow(2, float64(hi-lo+1)))
+		}
+	}
+
+	s.ctx.GetSessionVars().TiDBOptJoinReorderDPThreshold = n
+	solver := &joinReorderDPSolver{
+		baseSingleGroupJoinOrderSolver: &baseSingleGroupJoinOrderSolver{ctx: s.ctx},
+		newJoin:                        s.newMockJoin,
+	}
+	result, err := solver.solve(joinGroup, eqConds)
+	c.Assert(err, IsNil)
+
+	dpCost := s.planCumCost(result)
+	c.Assert(dpCost <= balancedBushyCost, IsTrue)
+	c.Assert(dpCost < leftDeepCost, IsTrue)
+}
+
 func (s *testJoinReorderDPSuite) TestDPReorderAllCartesian(c *C) {
 	joinGroup := make([]LogicalPlan, 0, 4)
 	joinGroup = append(joinGroup, s.newDataSource("a", 100))
@@ -286,6 +286,48 @@ func (cc *clientConn) getSessionVarsWaitTimeout(ctx context.Context) uint64 {
 	return waitTimeout
 }
 
+// getIdleReadTimeout returns how long this connection's next readPacket may
+// block before it's killed: ordinarily wait_timeout, but
+// tidb_idle_transaction_timeout instead whenever it's set, smaller, and this
+// connection currently holds an open transaction -- unless the connecting
+// user is in tidb_idle_transaction_timeout_allowlist, protecting against a
+// leaked open transaction holding locks and blocking GC for far longer than
+// an ordinary idle connection would.
+func (cc *clientConn) getIdleReadTimeout(ctx context.Context) uint64 {
+	waitTimeout := cc.getSessionVarsWaitTimeout(ctx)
+	if !cc.ctx.GetSessionVars().InTxn() {
+		return waitTimeout
+	}
+	valStr, exists := cc.ctx.GetSessionVars().GetSystemVar(variable.TiDBIdleTransactionTimeout)
+	if !exists {
+		return waitTimeout
+	}
+	idleTxnTimeout, err := strconv.ParseUint(valStr, 10, 64)
+	if err != nil || idleTxnTimeout == 0 || idleTxnTimeout >= waitTimeout {
+		return waitTimeout
+	}
+	if cc.idleTransactionTimeoutAllowlisted(ctx) {
+		return waitTimeout
+	}
+	return idleTxnTimeout
+}
+
+// idleTransactionTimeoutAllowlisted reports whether this connection's user is
+// listed in tidb_idle_transaction_timeout_allowlist, a comma-separated list
+// of user names exempt from tidb_idle_transaction_timeout.
+func (cc *clientConn) idleTransactionTimeoutAllowlisted(ctx context.Context) bool {
+	valStr, exists := cc.ctx.GetSessionVars().GetSystemVar(variable.TiDBIdleTransactionTimeoutAllowlist)
+	if !exists || len(valStr) == 0 {
+		return false
+	}
+	for _, user := range strings.Split(valStr, ",") {
+		if strings.TrimSpace(user) == cc.user {
+			return true
+		}
+	}
+	return false
+}
+
 type handshakeResponse41 struct {
 	Capability uint32
 	Collation  uint8
@@ -619,8 +661,9 @@ func (cc *clientConn) Run(ctx context.Context) {
 		}
 
 		cc.alloc.Reset()
-		// close connection when idle time is more than wait_timout
-		waitTimeout := cc.getSessionVarsWaitTimeout(ctx)
+		// close connection when idle time is more than wait_timeout, or sooner
+		// if tidb_idle_transaction_timeout applies to this connection
+		waitTimeout := cc.getIdleReadTimeout(ctx)
 		cc.pkt.setReadTimeout(time.Duration(waitTimeout) * time.Second)
 		start := time.Now()
 		data, err := cc.readPacket()
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/store/mockstore"
 )
 
@@ -194,6 +195,40 @@ func (ts ConnTestSuite) testGetSessionVarsWaitTimeout(c *C) {
 	c.Assert(cc.getSessionVarsWaitTimeout(context.Background()), Equals, 28800)
 }
 
+func (ts ConnTestSuite) TestGetIdleReadTimeout(c *C) {
+	store, err := mockstore.NewMockTikvStore()
+	c.Assert(err, IsNil)
+	_, err = session.BootstrapSession(store)
+	c.Assert(err, IsNil)
+	se, err := session.CreateSession4Test(store)
+	c.Assert(err, IsNil)
+	tc := &TiDBContext{
+		session: se,
+		stmts:   make(map[int]*TiDBStatement),
+	}
+	cc := &clientConn{
+		connectionID: 1,
+		server: &Server{
+			capability: defaultCapability,
+		},
+		ctx:  tc,
+		user: "root",
+	}
+	vars := se.GetSessionVars()
+
+	// No open transaction: tidb_idle_transaction_timeout never applies.
+	c.Assert(vars.SetSystemVar(variable.TiDBIdleTransactionTimeout, "1"), IsNil)
+	c.Assert(cc.getIdleReadTimeout(context.Background()), Equals, uint64(28800))
+
+	// Open transaction, timeout set and stricter than wait_timeout: applies.
+	vars.SetStatusFlag(mysql.ServerStatusInTrans, true)
+	c.Assert(cc.getIdleReadTimeout(context.Background()), Equals, uint64(1))
+
+	// Allowlisted user: exempt even with an open transaction.
+	c.Assert(vars.SetSystemVar(variable.TiDBIdleTransactionTimeoutAllowlist, "alice,root"), IsNil)
+	c.Assert(cc.getIdleReadTimeout(context.Background()), Equals, uint64(28800))
+}
+
 func mapIdentical(m1, m2 map[string]string) bool {
 	return mapBelong(m1, m2) && mapBelong(m2, m1)
 }
@@ -259,6 +259,24 @@ const (
 		count bigint(64) UNSIGNED NOT NULL,
 		index tbl(table_id, is_index, hist_id)
 	);`
+
+	// CreateAnalyzeJobsTable stores the history of auto-analyze trigger decisions,
+	// including ones that were skipped, so users can see why stats are stale.
+	CreateAnalyzeJobsTable = `CREATE TABLE IF NOT EXISTS mysql.analyze_jobs (
+		id BIGINT(64) UNSIGNED NOT NULL AUTO_INCREMENT,
+		table_schema VARCHAR(64) NOT NULL,
+		table_name VARCHAR(64) NOT NULL,
+		partition_name VARCHAR(64) NOT NULL DEFAULT '',
+		job_info VARCHAR(512) NOT NULL,
+		reason VARCHAR(256) NOT NULL DEFAULT '',
+		processed_rows BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		start_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		end_time TIMESTAMP NULL DEFAULT NULL,
+		state ENUM('pending', 'running', 'finished', 'failed', 'skipped') NOT NULL,
+		fail_reason TEXT,
+		PRIMARY KEY (id),
+		KEY (start_time)
+	);`
 )
 
 // bootstrap initiates system DB for a store.
@@ -330,6 +348,7 @@ const (
 	version29 = 29
 	version30 = 30
 	version31 = 31
+	version32 = 32
 )
 
 func checkBootstrapped(s Session) (bool, error) {
@@ -513,6 +532,10 @@ func upgrade(s Session) {
 		upgradeToVer31(s)
 	}
 
+	if ver < version32 {
+		upgradeToVer32(s)
+	}
+
 	updateBootstrapVer(s)
 	_, err = s.Execute(context.Background(), "COMMIT")
 
@@ -809,6 +832,10 @@ func upgradeToVer31(s Session) {
 	doReentrantDDL(s, "ALTER TABLE mysql.stats_histograms ADD COLUMN `last_analyze_pos` blob default null", infoschema.ErrColumnExists)
 }
 
+func upgradeToVer32(s Session) {
+	mustExecute(s, CreateAnalyzeJobsTable)
+}
+
 // updateBootstrapVer updates bootstrap version variable in mysql.TiDB table.
 func updateBootstrapVer(s Session) {
 	// Update bootstrap version.
@@ -867,6 +894,8 @@ func doDDLWorks(s Session) {
 	mustExecute(s, CreateBindInfoTable)
 	// Create stats_topn_store table.
 	mustExecute(s, CreateStatsTopNTable)
+	// Create analyze_jobs table.
+	mustExecute(s, CreateAnalyzeJobsTable)
 }
 
 // doDMLWorks executes DML statements in bootstrap stage.
@@ -62,6 +62,7 @@ import (
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/execdetails"
+	"github.com/pingcap/tidb/util/gcutil"
 	"github.com/pingcap/tidb/util/kvcache"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/sqlexec"
@@ -566,7 +567,15 @@ func (s *session) retry(ctx context.Context, maxCnt uint) (err error) {
 	defer func() {
 		s.sessionVars.RetryInfo.Retrying = false
 		// retryCnt only increments on retryable error, so +1 here.
-		metrics.SessionRetry.Observe(float64(retryCnt + 1))
+		// Autocommit single-statement transactions (history count == 1) are
+		// distinguished from explicit multi-statement transactions so that
+		// statement-level retries for write conflicts can be observed
+		// separately from full transaction retries.
+		retryType := "transaction"
+		if nh := GetHistory(s); nh.Count() <= 1 {
+			retryType = "statement"
+		}
+		metrics.SessionRetry.WithLabelValues(retryType).Observe(float64(retryCnt + 1))
 		s.sessionVars.SetStatusFlag(mysql.ServerStatusInTrans, false)
 		if err != nil {
 			s.RollbackTxn(ctx)
@@ -907,13 +916,22 @@ func (s *session) ParseSQL(ctx context.Context, sql, charset, collation string)
 
 func (s *session) SetProcessInfo(sql string, t time.Time, command byte) {
 	pi := util.ProcessInfo{
-		ID:      s.sessionVars.ConnectionID,
-		DB:      s.sessionVars.CurrentDB,
-		Command: command,
-		Plan:    s.currentPlan,
-		Time:    t,
-		State:   s.Status(),
-		Info:    sql,
+		ID:         s.sessionVars.ConnectionID,
+		DB:         s.sessionVars.CurrentDB,
+		Command:    command,
+		Plan:       s.currentPlan,
+		Time:       t,
+		State:      s.Status(),
+		Info:       sql,
+		MemTracker: s.sessionVars.StmtCtx.MemTracker,
+		StmtCtx:    s.sessionVars.StmtCtx,
+	}
+	if s.sessionVars.InTxn() {
+		pi.CurTxnStartTS = s.sessionVars.TxnCtx.StartTS
+		pi.CurTxnStartTime = s.sessionVars.TxnCtx.CreateTime
+	}
+	if s.txn.Valid() {
+		pi.CurTxnMemBuffer = s.txn.GetMemBuffer()
 	}
 	if s.sessionVars.User != nil {
 		pi.User = s.sessionVars.User.Username
@@ -1285,6 +1303,12 @@ func (s *session) Close() {
 	s.RollbackTxn(ctx)
 	if s.sessionVars != nil {
 		s.sessionVars.WithdrawAllPreparedStmt()
+		if s.sessionVars.GCSafePointPinID != "" {
+			if err := gcutil.UnpinGCSafePoint(s, s.sessionVars.GCSafePointPinID); err != nil {
+				logutil.Logger(context.Background()).Warn("release GC safe point pin failed", zap.Error(err))
+			}
+			s.sessionVars.GCSafePointPinID = ""
+		}
 	}
 }
 
@@ -1559,7 +1583,7 @@ func createSessionWithDomain(store kv.Storage, dom *domain.Domain) (*session, er
 
 const (
 	notBootstrapped         = 0
-	currentBootstrapVersion = 31
+	currentBootstrapVersion = 32
 )
 
 func getStoreBootstrapVersion(store kv.Storage) int64 {
@@ -68,6 +68,12 @@ type StatementContext struct {
 	InNullRejectCheck      bool
 	AllowInvalidDate       bool
 
+	// MaxExecutionTime is the statement's effective MAX_EXECUTION_TIME, in
+	// milliseconds; 0 means no limit. It is set from the query's
+	// MAX_EXECUTION_TIME optimizer hint, if present, overriding the session's
+	// max_execution_time default. See executor.ExecStmt.Exec for enforcement.
+	MaxExecutionTime uint64
+
 	// mu struct holds variables that change during execution.
 	mu struct {
 		sync.Mutex
@@ -92,6 +98,10 @@ type StatementContext struct {
 		updated uint64
 		copied  uint64
 		touched uint64
+		// duplicateKey counts rows INSERT ... ON DUPLICATE KEY UPDATE
+		// resolved as a conflict, independent of the "updated"/"touched"
+		// counters above since those are shared with plain UPDATE.
+		duplicateKey uint64
 
 		message           string
 		warnings          []SQLWarn
@@ -234,6 +244,22 @@ func (sc *StatementContext) AddTouchedRows(rows uint64) {
 	sc.mu.Unlock()
 }
 
+// DuplicateKeyRows is the number of rows INSERT ... ON DUPLICATE KEY UPDATE
+// resolved as a conflict against an existing key.
+func (sc *StatementContext) DuplicateKeyRows() uint64 {
+	sc.mu.Lock()
+	rows := sc.mu.duplicateKey
+	sc.mu.Unlock()
+	return rows
+}
+
+// AddDuplicateKeyRows adds duplicate-key conflict rows.
+func (sc *StatementContext) AddDuplicateKeyRows(rows uint64) {
+	sc.mu.Lock()
+	sc.mu.duplicateKey += rows
+	sc.mu.Unlock()
+}
+
 // GetMessage returns the extra message of the last executed command, if there is no message, it returns empty string
 func (sc *StatementContext) GetMessage() string {
 	sc.mu.Lock()
@@ -472,7 +498,7 @@ func (sc *StatementContext) CopTasksDetails() *CopTasksDetails {
 	return d
 }
 
-//CopTasksDetails collects some useful information of cop-tasks during execution.
+// CopTasksDetails collects some useful information of cop-tasks during execution.
 type CopTasksDetails struct {
 	NumCopTasks int
 
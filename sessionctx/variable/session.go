@@ -303,6 +303,9 @@ type SessionVars struct {
 	// BatchDelete indicates if we should split delete data into multiple batches.
 	BatchDelete bool
 
+	// BatchUpdate indicates if we should split update data into multiple batches.
+	BatchUpdate bool
+
 	// BatchCommit indicates if we should split the transaction into multiple batches.
 	BatchCommit bool
 
@@ -351,11 +354,57 @@ type SessionVars struct {
 	// to use the greedy join reorder algorithm.
 	TiDBOptJoinReorderThreshold int
 
+	// TiDBOptJoinReorderDPThreshold bounds how large a connected component of
+	// a join group the DP join reorder solver will still run on; components
+	// bigger than this fall back to the greedy solver instead.
+	TiDBOptJoinReorderDPThreshold int
+
 	// SlowQueryFile indicates which slow query log file for SLOW_QUERY table to parse.
 	SlowQueryFile string
 
 	// EnableFastAnalyze indicates whether to take fast analyze.
 	EnableFastAnalyze bool
+
+	// EnableInfoSchemaFastLoad makes information_schema.tables/columns answer
+	// from the schema cache snapshot without triggering a live AUTO_INCREMENT
+	// fetch per table, at the cost of reporting AUTO_INCREMENT's last-known
+	// cached value instead of the current one.
+	EnableInfoSchemaFastLoad bool
+
+	// MaxExecutionTime is the session default for the 'max_execution_time'
+	// system variable, in milliseconds. A statement's own MAX_EXECUTION_TIME
+	// optimizer hint, when present, takes precedence over this default; 0
+	// means no limit. See ExecStmt.Exec for where this is enforced.
+	MaxExecutionTime uint64
+
+	// IdleTransactionTimeout is the session default for the
+	// 'tidb_idle_transaction_timeout' system variable, in seconds: how long a
+	// connection may sit idle while holding an open transaction before the
+	// server kills it, distinct from 'wait_timeout' which applies regardless
+	// of transaction state. 0 means no limit. See clientConn.Run for where
+	// this is enforced.
+	IdleTransactionTimeout uint64
+
+	// SnapshotGCPinTimeout is the session value of
+	// 'tidb_snapshot_gc_pin_timeout', in seconds: when positive and
+	// 'tidb_snapshot' is subsequently set, the GC safe point is held at
+	// that snapshot's ts for up to this many seconds via
+	// gcutil.PinGCSafePoint (see executor/set.go's SetExecutor, which has
+	// the sessionctx.Context this needs to register the pin cluster-wide),
+	// capped by the global 'tidb_snapshot_gc_pin_timeout_max'. 0 means no
+	// pin is registered.
+	SnapshotGCPinTimeout uint64
+
+	// GCSafePointPinID is the UUID gcutil.PinGCSafePoint returned for the GC
+	// safe point pin this session currently holds, or "" if it holds none.
+	// Keeping the UUID, rather than just a bool, is what lets
+	// gcutil.UnpinGCSafePoint target this session's own pin specifically --
+	// ConnectionID isn't cluster-unique, so it can't be used as the pin's
+	// identity. An empty value also means session shutdown only pays for a
+	// gcutil.UnpinGCSafePoint round trip -- and only risks its "pool already
+	// closed" error during teardown -- for the rare session that actually
+	// used 'tidb_snapshot_gc_pin_timeout'.
+	GCSafePointPinID string
 }
 
 // ConnectionInfo present connection used by audit.
@@ -381,31 +430,32 @@ type ConnectionInfo struct {
 // NewSessionVars creates a session vars object.
 func NewSessionVars() *SessionVars {
 	vars := &SessionVars{
-		Users:                       make(map[string]string),
-		systems:                     make(map[string]string),
-		PreparedStmts:               make(map[uint32]*ast.Prepared),
-		PreparedStmtNameToID:        make(map[string]uint32),
-		PreparedParams:              make([]types.Datum, 0, 10),
-		TxnCtx:                      &TransactionContext{},
-		KVVars:                      kv.NewVariables(),
-		RetryInfo:                   &RetryInfo{},
-		ActiveRoles:                 make([]*auth.RoleIdentity, 0, 10),
-		StrictSQLMode:               true,
-		Status:                      mysql.ServerStatusAutocommit,
-		StmtCtx:                     new(stmtctx.StatementContext),
-		AllowAggPushDown:            false,
-		OptimizerSelectivityLevel:   DefTiDBOptimizerSelectivityLevel,
-		RetryLimit:                  DefTiDBRetryLimit,
-		DisableTxnAutoRetry:         DefTiDBDisableTxnAutoRetry,
-		DDLReorgPriority:            kv.PriorityLow,
-		AllowInSubqToJoinAndAgg:     DefOptInSubqToJoinAndAgg,
-		CorrelationThreshold:        DefOptCorrelationThreshold,
-		CorrelationExpFactor:        DefOptCorrelationExpFactor,
-		EnableRadixJoin:             false,
-		L2CacheSize:                 cpuid.CPU.Cache.L2,
-		CommandValue:                uint32(mysql.ComSleep),
-		TiDBOptJoinReorderThreshold: DefTiDBOptJoinReorderThreshold,
-		SlowQueryFile:               config.GetGlobalConfig().Log.SlowQueryFile,
+		Users:                         make(map[string]string),
+		systems:                       make(map[string]string),
+		PreparedStmts:                 make(map[uint32]*ast.Prepared),
+		PreparedStmtNameToID:          make(map[string]uint32),
+		PreparedParams:                make([]types.Datum, 0, 10),
+		TxnCtx:                        &TransactionContext{},
+		KVVars:                        kv.NewVariables(),
+		RetryInfo:                     &RetryInfo{},
+		ActiveRoles:                   make([]*auth.RoleIdentity, 0, 10),
+		StrictSQLMode:                 true,
+		Status:                        mysql.ServerStatusAutocommit,
+		StmtCtx:                       new(stmtctx.StatementContext),
+		AllowAggPushDown:              false,
+		OptimizerSelectivityLevel:     DefTiDBOptimizerSelectivityLevel,
+		RetryLimit:                    DefTiDBRetryLimit,
+		DisableTxnAutoRetry:           DefTiDBDisableTxnAutoRetry,
+		DDLReorgPriority:              kv.PriorityLow,
+		AllowInSubqToJoinAndAgg:       DefOptInSubqToJoinAndAgg,
+		CorrelationThreshold:          DefOptCorrelationThreshold,
+		CorrelationExpFactor:          DefOptCorrelationExpFactor,
+		EnableRadixJoin:               false,
+		L2CacheSize:                   cpuid.CPU.Cache.L2,
+		CommandValue:                  uint32(mysql.ComSleep),
+		TiDBOptJoinReorderThreshold:   DefTiDBOptJoinReorderThreshold,
+		TiDBOptJoinReorderDPThreshold: DefTiDBOptJoinReorderDPThreshold,
+		SlowQueryFile:                 config.GetGlobalConfig().Log.SlowQueryFile,
 	}
 	vars.Concurrency = Concurrency{
 		IndexLookupConcurrency:     DefIndexLookupConcurrency,
@@ -707,6 +757,8 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.BatchInsert = TiDBOptOn(val)
 	case TiDBBatchDelete:
 		s.BatchDelete = TiDBOptOn(val)
+	case TiDBBatchUpdate:
+		s.BatchUpdate = TiDBOptOn(val)
 	case TiDBBatchCommit:
 		s.BatchCommit = TiDBOptOn(val)
 	case TiDBDMLBatchSize:
@@ -763,6 +815,8 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.EnableWindowFunction = TiDBOptOn(val)
 	case TiDBOptJoinReorderThreshold:
 		s.TiDBOptJoinReorderThreshold = tidbOptPositiveInt32(val, DefTiDBOptJoinReorderThreshold)
+	case TiDBOptJoinReorderDPThreshold:
+		s.TiDBOptJoinReorderDPThreshold = tidbOptPositiveInt32(val, DefTiDBOptJoinReorderDPThreshold)
 	case TiDBCheckMb4ValueInUTF8:
 		config.GetGlobalConfig().CheckMb4ValueInUTF8 = TiDBOptOn(val)
 	case TiDBSlowQueryFile:
@@ -771,6 +825,26 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.EnableFastAnalyze = TiDBOptOn(val)
 	case TiDBWaitTableSplitFinish:
 		s.WaitTableSplitFinish = TiDBOptOn(val)
+	case TiDBEnableInfoSchemaFastLoad:
+		s.EnableInfoSchemaFastLoad = TiDBOptOn(val)
+	case MaxExecutionTime:
+		timeoutMS := tidbOptInt64(val, 0)
+		if timeoutMS < 0 {
+			timeoutMS = 0
+		}
+		s.MaxExecutionTime = uint64(timeoutMS)
+	case TiDBIdleTransactionTimeout:
+		timeoutS := tidbOptInt64(val, DefTiDBIdleTransactionTimeout)
+		if timeoutS < 0 {
+			timeoutS = 0
+		}
+		s.IdleTransactionTimeout = uint64(timeoutS)
+	case TiDBSnapshotGCPinTimeout:
+		timeoutS := tidbOptInt64(val, DefTiDBSnapshotGCPinTimeout)
+		if timeoutS < 0 {
+			timeoutS = 0
+		}
+		s.SnapshotGCPinTimeout = uint64(timeoutS)
 	}
 	s.systems[name] = val
 	return nil
@@ -943,6 +1017,13 @@ const (
 	SlowLogCopWaitAddr = "Cop_wait_addr"
 	// SlowLogMemMax is the max number bytes of memory used in this statement.
 	SlowLogMemMax = "Mem_max"
+	// SlowLogDuplicatesStr is the number of rows the statement resolved as
+	// duplicate keys, e.g. via INSERT ... ON DUPLICATE KEY UPDATE.
+	SlowLogDuplicatesStr = "Duplicates"
+	// SlowLogPlanStats is the per-operator runtime stats breakdown
+	// (operator id -> time/loops/rows), one operator per comma-separated
+	// entry.
+	SlowLogPlanStats = "Plan_stats"
 )
 
 // SlowLogFormat uses for formatting slow log.
@@ -962,9 +1043,10 @@ const (
 // # Cop_process: Avg_time: 1s P90_time: 2s Max_time: 3s Max_addr: 10.6.131.78
 // # Cop_wait: Avg_time: 10ms P90_time: 20ms Max_time: 30ms Max_Addr: 10.6.131.79
 // # Memory_max: 4096
+// # Plan_stats: HashAgg_5{time:1.5s,loops:3,rows:1};TableReader_9{time:1.2s,loops:3,rows:100000}
 // select * from t_slim;
 func (s *SessionVars) SlowLogFormat(txnTS uint64, costTime time.Duration, execDetail execdetails.ExecDetails, indexIDs string, digest string,
-	statsInfos map[string]uint64, copTasks *stmtctx.CopTasksDetails, memMax int64, sql string) string {
+	statsInfos map[string]uint64, copTasks *stmtctx.CopTasksDetails, memMax int64, planStats string, sql string) string {
 	var buf bytes.Buffer
 	execDetailStr := execDetail.String()
 	buf.WriteString(SlowLogRowPrefixStr + SlowLogTxnStartTSStr + SlowLogSpaceMarkStr + strconv.FormatUint(txnTS, 10) + "\n")
@@ -1024,6 +1106,12 @@ func (s *SessionVars) SlowLogFormat(txnTS uint64, costTime time.Duration, execDe
 	if memMax > 0 {
 		buf.WriteString(SlowLogRowPrefixStr + SlowLogMemMax + SlowLogSpaceMarkStr + strconv.FormatInt(memMax, 10) + "\n")
 	}
+	if dup := s.StmtCtx.DuplicateKeyRows(); dup > 0 {
+		buf.WriteString(SlowLogRowPrefixStr + SlowLogDuplicatesStr + SlowLogSpaceMarkStr + strconv.FormatUint(dup, 10) + "\n")
+	}
+	if len(planStats) > 0 {
+		buf.WriteString(SlowLogRowPrefixStr + SlowLogPlanStats + SlowLogSpaceMarkStr + planStats + "\n")
+	}
 	if len(sql) == 0 {
 		sql = ";"
 	}
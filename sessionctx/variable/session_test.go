@@ -136,6 +136,26 @@ func (*testSessionSuite) TestSlowLogFormat(c *C) {
 select * from t;`
 	sql := "select * from t"
 	digest := parser.DigestHash(sql)
-	logString := seVar.SlowLogFormat(txnTS, costTime, execDetail, "[1,2]", digest, statsInfos, copTasks, memMax, sql)
+	logString := seVar.SlowLogFormat(txnTS, costTime, execDetail, "[1,2]", digest, statsInfos, copTasks, memMax, "", sql)
 	c.Assert(logString, Equals, resultString)
+
+	resultStringWithPlanStats := `# Txn_start_ts: 406649736972468225
+# User: root@192.168.0.1
+# Conn_ID: 1
+# Query_time: 1
+# Process_time: 2 Wait_time: 60 Backoff_time: 0.001 Request_count: 2 Total_keys: 10000 Process_keys: 20001
+# DB: test
+# Index_ids: [1,2]
+# Is_internal: true
+# Digest: 42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772
+# Stats: t1:pseudo
+# Num_cop_tasks: 10
+# Cop_proc_avg: 1 Cop_proc_p90: 2 Cop_proc_max: 3 Cop_proc_addr: 10.6.131.78
+# Cop_wait_avg: 0.01 Cop_wait_p90: 0.02 Cop_wait_max: 0.03 Cop_wait_addr: 10.6.131.79
+# Mem_max: 2333
+# Plan_stats: TableReader_9{time:1.2s,loops:3,rows:100000}
+select * from t;`
+	logString = seVar.SlowLogFormat(txnTS, costTime, execDetail, "[1,2]", digest, statsInfos, copTasks, memMax,
+		"TableReader_9{time:1.2s,loops:3,rows:100000}", sql)
+	c.Assert(logString, Equals, resultStringWithPlanStats)
 }
@@ -479,6 +479,7 @@ var defaultSysVars = []*SysVar{
 	{ScopeGlobal, "gtid_purged", ""},
 	{ScopeGlobal, "max_binlog_stmt_cache_size", "18446744073709547520"},
 	{ScopeGlobal | ScopeSession, "lock_wait_timeout", "31536000"},
+	{ScopeGlobal | ScopeSession, MaxExecutionTime, "0"},
 	{ScopeGlobal | ScopeSession, "read_buffer_size", "131072"},
 	{ScopeNone, "innodb_read_io_threads", "4"},
 	{ScopeGlobal | ScopeSession, MaxSpRecursionDepth, "0"},
@@ -648,6 +649,7 @@ var defaultSysVars = []*SysVar{
 	{ScopeGlobal | ScopeSession, TiDBSkipUTF8Check, BoolToIntStr(DefSkipUTF8Check)},
 	{ScopeSession, TiDBBatchInsert, BoolToIntStr(DefBatchInsert)},
 	{ScopeSession, TiDBBatchDelete, BoolToIntStr(DefBatchDelete)},
+	{ScopeSession, TiDBBatchUpdate, BoolToIntStr(DefBatchUpdate)},
 	{ScopeSession, TiDBBatchCommit, BoolToIntStr(DefBatchCommit)},
 	{ScopeSession, TiDBDMLBatchSize, strconv.Itoa(DefDMLBatchSize)},
 	{ScopeSession, TiDBCurrentTS, strconv.Itoa(DefCurretTS)},
@@ -691,9 +693,15 @@ var defaultSysVars = []*SysVar{
 	{ScopeSession, TiDBForcePriority, mysql.Priority2Str[DefTiDBForcePriority]},
 	{ScopeSession, TiDBEnableRadixJoin, BoolToIntStr(DefTiDBUseRadixJoin)},
 	{ScopeGlobal | ScopeSession, TiDBOptJoinReorderThreshold, strconv.Itoa(DefTiDBOptJoinReorderThreshold)},
+	{ScopeGlobal | ScopeSession, TiDBOptJoinReorderDPThreshold, strconv.Itoa(DefTiDBOptJoinReorderDPThreshold)},
 	{ScopeSession, TiDBCheckMb4ValueInUTF8, BoolToIntStr(config.GetGlobalConfig().CheckMb4ValueInUTF8)},
 	{ScopeSession, TiDBSlowQueryFile, ""},
 	{ScopeSession, TiDBWaitTableSplitFinish, BoolToIntStr(DefTiDBWaitTableSplitFinish)},
+	{ScopeSession, TiDBEnableInfoSchemaFastLoad, BoolToIntStr(DefTiDBEnableInfoSchemaFastLoad)},
+	{ScopeGlobal | ScopeSession, TiDBIdleTransactionTimeout, strconv.Itoa(DefTiDBIdleTransactionTimeout)},
+	{ScopeGlobal, TiDBIdleTransactionTimeoutAllowlist, ""},
+	{ScopeGlobal | ScopeSession, TiDBSnapshotGCPinTimeout, strconv.Itoa(DefTiDBSnapshotGCPinTimeout)},
+	{ScopeGlobal, TiDBSnapshotGCPinTimeoutMax, strconv.Itoa(DefTiDBSnapshotGCPinTimeoutMax)},
 }
 
 // SynonymsSysVariables is synonyms of system variables.
@@ -804,6 +812,8 @@ const (
 	BlockEncryptionMode = "block_encryption_mode"
 	// WaitTimeout is the name for 'wait_timeout' system variable.
 	WaitTimeout = "wait_timeout"
+	// MaxExecutionTime is the name for 'max_execution_time' system variable.
+	MaxExecutionTime = "max_execution_time"
 	// ValidatePasswordNumberCount is the name of 'validate_password_number_count' system variable.
 	ValidatePasswordNumberCount = "validate_password_number_count"
 	// ValidatePasswordLength is the name of 'validate_password_length' system variable.
@@ -73,6 +73,10 @@ const (
 	// split data into multiple batches and use a single txn for each batch. This will be helpful when deleting large data.
 	TiDBBatchDelete = "tidb_batch_delete"
 
+	// tidb_batch_update is used to enable/disable auto-split update data. If set this option on, update executor will automatically
+	// split data into multiple batches and use a single txn for each batch. This will be helpful when updating large data.
+	TiDBBatchUpdate = "tidb_batch_update"
+
 	// tidb_batch_commit is used to enable/disable auto-split the transaction.
 	// If set this option on, the transaction will be committed when it reaches stmt-count-limit and starts a new transaction.
 	TiDBBatchCommit = "tidb_batch_commit"
@@ -260,11 +264,49 @@ const (
 	// we'll choose a rather time consuming algorithm to calculate the join order.
 	TiDBOptJoinReorderThreshold = "tidb_opt_join_reorder_threshold"
 
+	// TiDBOptJoinReorderDPThreshold bounds how large a connected component of
+	// a join group the DP join reorder solver will still run on, rather than
+	// falling back to the greedy solver for that component.
+	TiDBOptJoinReorderDPThreshold = "tidb_opt_join_reorder_dp_threshold"
+
 	// SlowQueryFile indicates which slow query log file for SLOW_QUERY table to parse.
 	TiDBSlowQueryFile = "tidb_slow_query_file"
 
 	// TiDBEnableFastAnalyze indicates to use fast analyze.
 	TiDBEnableFastAnalyze = "tidb_enable_fast_analyze"
+
+	// TiDBEnableInfoSchemaFastLoad makes information_schema.tables/columns
+	// skip the per-table live AUTO_INCREMENT fetch and answer from the
+	// schema cache snapshot instead.
+	TiDBEnableInfoSchemaFastLoad = "tidb_enable_infoschema_fast_load"
+
+	// TiDBIdleTransactionTimeout is the name for 'tidb_idle_transaction_timeout'
+	// system variable. It bounds how long, in seconds, a connection may sit
+	// idle (waiting for the next statement from the client) while it holds an
+	// open transaction, distinct from 'wait_timeout' which bounds idle time
+	// regardless of transaction state. 0 means no limit.
+	TiDBIdleTransactionTimeout = "tidb_idle_transaction_timeout"
+
+	// TiDBIdleTransactionTimeoutAllowlist is the name for the
+	// 'tidb_idle_transaction_timeout_allowlist' system variable: a
+	// comma-separated list of user names exempt from
+	// 'tidb_idle_transaction_timeout'.
+	TiDBIdleTransactionTimeoutAllowlist = "tidb_idle_transaction_timeout_allowlist"
+
+	// TiDBSnapshotGCPinTimeout is the name for
+	// 'tidb_snapshot_gc_pin_timeout'. When set to a positive number of
+	// seconds before 'tidb_snapshot' is set, the session additionally holds
+	// the GC safe point at 'tidb_snapshot''s ts for up to that many seconds,
+	// so a multi-connection logical dump that all reads the same
+	// 'tidb_snapshot' value gets a cluster-consistent export without races
+	// against GC. 0 (the default) registers no pin. The value is clamped to
+	// 'tidb_snapshot_gc_pin_timeout_max'.
+	TiDBSnapshotGCPinTimeout = "tidb_snapshot_gc_pin_timeout"
+
+	// TiDBSnapshotGCPinTimeoutMax is the name for
+	// 'tidb_snapshot_gc_pin_timeout_max', the global cap (in seconds) on
+	// 'tidb_snapshot_gc_pin_timeout'.
+	TiDBSnapshotGCPinTimeoutMax = "tidb_snapshot_gc_pin_timeout_max"
 )
 
 // Default TiDB system variable values.
@@ -289,6 +331,7 @@ const (
 	DefOptInSubqToJoinAndAgg         = true
 	DefBatchInsert                   = false
 	DefBatchDelete                   = false
+	DefBatchUpdate                   = false
 	DefBatchCommit                   = false
 	DefCurretTS                      = 0
 	DefInitChunkSize                 = 32
@@ -320,10 +363,15 @@ const (
 	DefTiDBUseRadixJoin              = false
 	DefEnableWindowFunction          = false
 	DefTiDBOptJoinReorderThreshold   = 0
+	DefTiDBOptJoinReorderDPThreshold = 20
 	DefTiDBDDLSlowOprThreshold       = 300
 	DefTiDBUseFastAnalyze            = false
 	DefTiDBSkipIsolationLevelCheck   = false
 	DefTiDBWaitTableSplitFinish      = false
+	DefTiDBEnableInfoSchemaFastLoad  = false
+	DefTiDBIdleTransactionTimeout    = 0
+	DefTiDBSnapshotGCPinTimeout      = 0
+	DefTiDBSnapshotGCPinTimeoutMax   = 3600
 )
 
 // Process global variables.
@@ -361,7 +361,7 @@ func ValidateSetSystemVar(vars *SessionVars, name string, value string) (string,
 	case AutocommitVar, TiDBSkipUTF8Check, TiDBOptAggPushDown,
 		TiDBOptInSubqToJoinAndAgg, TiDBEnableFastAnalyze,
 		TiDBBatchInsert, TiDBDisableTxnAutoRetry, TiDBEnableStreaming,
-		TiDBBatchDelete, TiDBBatchCommit, TiDBEnableCascadesPlanner, TiDBEnableWindowFunction,
+		TiDBBatchDelete, TiDBBatchUpdate, TiDBBatchCommit, TiDBEnableCascadesPlanner, TiDBEnableWindowFunction,
 		TiDBCheckMb4ValueInUTF8:
 		if strings.EqualFold(value, "ON") || value == "1" || strings.EqualFold(value, "OFF") || value == "0" {
 			return value, nil
@@ -493,6 +493,17 @@ func ValidateSetSystemVar(vars *SessionVars, name string, value string) (string,
 		if v < 0 || v >= 64 {
 			return value, errors.Errorf("tidb_join_order_algo_threshold(%d) cannot be smaller than 0 or larger than 63", v)
 		}
+	case TiDBOptJoinReorderDPThreshold:
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return value, ErrWrongTypeForVar.GenWithStackByArgs(name)
+		}
+		// Join reorder DP tracks subgraphs of a connected component as bits of
+		// a uint bitmask, so the component size this threshold allows through
+		// to the DP solver can't reach or exceed the bitmask's width.
+		if v < 1 || v >= 64 {
+			return value, errors.Errorf("tidb_opt_join_reorder_dp_threshold(%d) cannot be smaller than 1 or larger than 63", v)
+		}
 	}
 	return value, nil
 }
@@ -562,6 +573,12 @@ func parseTimeZone(s string) (*time.Location, error) {
 	return nil, ErrUnknownTimeZone.GenWithStackByArgs(s)
 }
 
+// setSnapshotTS only updates s.SnapshotTS. Registering (or releasing) the
+// cluster-wide GC safe point pin that 'tidb_snapshot_gc_pin_timeout'
+// controls needs a sessionctx.Context to write mysql.tidb, which this
+// package can't depend on without an import cycle -- that's handled by
+// SetExecutor in executor/set.go, the caller of SetSessionSystemVar, right
+// after it validates the new snapshot.
 func setSnapshotTS(s *SessionVars, sVal string) error {
 	if sVal == "" {
 		s.SnapshotTS = 0
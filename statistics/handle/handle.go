@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/statistics"
@@ -255,6 +256,7 @@ func (h *Handle) UpdateTableStats(tables []*statistics.Table, deletedIDs []int64
 // LoadNeededHistograms will load histograms for those needed columns.
 func (h *Handle) LoadNeededHistograms() error {
 	cols := statistics.HistogramNeededColumns.AllCols()
+	metrics.StatsSyncLoadQueueDepth.Set(float64(len(cols)))
 	for _, col := range cols {
 		tbl, ok := h.StatsCache.Load().(StatsCache)[col.TableID]
 		if !ok {
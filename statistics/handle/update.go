@@ -716,7 +716,7 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) {
 			if pi == nil {
 				statsTbl := h.GetTableStats(tblInfo)
 				sql := fmt.Sprintf("analyze table %s", tblName)
-				analyzed := h.autoAnalyzeTable(tblInfo, statsTbl, start, end, autoAnalyzeRatio, sql)
+				analyzed := h.autoAnalyzeTable(db, tblInfo, "", statsTbl, start, end, autoAnalyzeRatio, sql)
 				if analyzed {
 					return
 				}
@@ -725,7 +725,7 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) {
 			for _, def := range pi.Definitions {
 				sql := fmt.Sprintf("analyze table %s partition `%s`", tblName, def.Name.O)
 				statsTbl := h.GetPartitionStats(tblInfo, def.ID)
-				analyzed := h.autoAnalyzeTable(tblInfo, statsTbl, start, end, autoAnalyzeRatio, sql)
+				analyzed := h.autoAnalyzeTable(db, tblInfo, def.Name.O, statsTbl, start, end, autoAnalyzeRatio, sql)
 				if analyzed {
 					return
 				}
@@ -736,13 +736,13 @@ func (h *Handle) HandleAutoAnalyze(is infoschema.InfoSchema) {
 	return
 }
 
-func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics.Table, start, end time.Time, ratio float64, sql string) bool {
+func (h *Handle) autoAnalyzeTable(db string, tblInfo *model.TableInfo, partitionName string, statsTbl *statistics.Table, start, end time.Time, ratio float64, sql string) bool {
 	if statsTbl.Pseudo || statsTbl.Count < AutoAnalyzeMinCnt {
 		return false
 	}
 	if needAnalyze, reason := NeedAnalyzeTable(statsTbl, 20*h.Lease, ratio, start, end, time.Now()); needAnalyze {
 		logutil.Logger(context.Background()).Info("[stats] auto analyze triggered", zap.String("sql", sql), zap.String("reason", reason))
-		h.execAutoAnalyze(sql)
+		h.execAutoAnalyze(db, tblInfo.Name.O, partitionName, sql, reason, statsTbl.Count)
 		return true
 	}
 	for _, idx := range tblInfo.Indices {
@@ -751,15 +751,16 @@ func (h *Handle) autoAnalyzeTable(tblInfo *model.TableInfo, statsTbl *statistics
 		}
 		if _, ok := statsTbl.Indices[idx.ID]; !ok {
 			sql = fmt.Sprintf("%s index `%s`", sql, idx.Name.O)
+			reason := fmt.Sprintf("index %s unanalyzed", idx.Name.O)
 			logutil.Logger(context.Background()).Info("[stats] auto analyze for unanalyzed", zap.String("sql", sql))
-			h.execAutoAnalyze(sql)
+			h.execAutoAnalyze(db, tblInfo.Name.O, partitionName, sql, reason, statsTbl.Count)
 			return true
 		}
 	}
 	return false
 }
 
-func (h *Handle) execAutoAnalyze(sql string) {
+func (h *Handle) execAutoAnalyze(db, table, partitionName, sql, reason string, rowCount int64) {
 	startTime := time.Now()
 	_, _, err := h.restrictedExec.ExecRestrictedSQL(nil, sql)
 	dur := time.Since(startTime)
@@ -770,6 +771,37 @@ func (h *Handle) execAutoAnalyze(sql string) {
 	} else {
 		metrics.AutoAnalyzeCounter.WithLabelValues("succ").Inc()
 	}
+	h.recordAnalyzeJob(db, table, partitionName, sql, reason, rowCount, startTime, err)
+}
+
+// recordAnalyzeJob persists an auto-analyze trigger decision into
+// mysql.analyze_jobs, including the reason it fired, so that users can see
+// why stats became (or stayed) stale across restarts. History older than
+// analyzeJobsRetentionDays is pruned on every insert.
+func (h *Handle) recordAnalyzeJob(db, table, partitionName, sql, reason string, rowCount int64, startTime time.Time, err error) {
+	state := "finished"
+	failReason := ""
+	if err != nil {
+		state = "failed"
+		failReason = err.Error()
+	}
+	insertSQL := fmt.Sprintf(
+		"insert into mysql.analyze_jobs (table_schema, table_name, partition_name, job_info, reason, processed_rows, start_time, end_time, state, fail_reason) values (%s, %s, %s, %s, %s, %d, %s, now(), %s, %s)",
+		quoteSQLString(db), quoteSQLString(table), quoteSQLString(partitionName), quoteSQLString(sql), quoteSQLString(reason), rowCount,
+		quoteSQLString(startTime.Format("2006-01-02 15:04:05.999999")), quoteSQLString(state), quoteSQLString(failReason))
+	if _, _, err := h.restrictedExec.ExecRestrictedSQL(nil, insertSQL); err != nil {
+		logutil.Logger(context.Background()).Error("[stats] record analyze job failed", zap.Error(err))
+	}
+	pruneSQL := fmt.Sprintf("delete from mysql.analyze_jobs where start_time < date_sub(now(), interval %d day)", analyzeJobsRetentionDays)
+	if _, _, err := h.restrictedExec.ExecRestrictedSQL(nil, pruneSQL); err != nil {
+		logutil.Logger(context.Background()).Error("[stats] prune analyze_jobs history failed", zap.Error(err))
+	}
+}
+
+const analyzeJobsRetentionDays = 7
+
+func quoteSQLString(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
 }
 
 // formatBuckets formats bucket from lowBkt to highBkt.
@@ -648,7 +648,7 @@ func (c *Column) String() string {
 
 // HistogramNeededColumns stores the columns whose Histograms need to be loaded from physical kv layer.
 // Currently, we only load index/pk's Histogram from kv automatically. Columns' are loaded by needs.
-var HistogramNeededColumns = neededColumnMap{cols: map[tableColumnID]struct{}{}}
+var HistogramNeededColumns = neededColumnMap{cols: map[tableColumnID]SyncLoadPriority{}}
 
 // IsInvalid checks if this column is invalid. If this column has histogram but not loaded yet, then we mark it
 // as need histogram.
@@ -658,7 +658,8 @@ func (c *Column) IsInvalid(sc *stmtctx.StatementContext, collPseudo bool) bool {
 	}
 	if c.NDV > 0 && c.Len() == 0 && sc != nil {
 		sc.SetHistogramsNotLoad()
-		HistogramNeededColumns.insert(tableColumnID{TableID: c.PhysicalID, ColumnID: c.Info.ID})
+		// Needed by a statement that is executing right now, so it takes the high-priority lane.
+		HistogramNeededColumns.insert(tableColumnID{TableID: c.PhysicalID, ColumnID: c.Info.ID}, SyncLoadHighPriority)
 	}
 	return c.TotalRowCount() == 0 || (c.NDV > 0 && c.Len() == 0)
 }
@@ -16,6 +16,7 @@ package statistics
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 
@@ -132,11 +133,26 @@ type tableColumnID struct {
 	ColumnID int64
 }
 
+// SyncLoadPriority orders how eagerly a column's histogram should be
+// fetched by Handle.LoadNeededHistograms. Columns needed by a currently
+// executing query are loaded ahead of ones requested by background
+// warmups such as plan-cache preloading.
+type SyncLoadPriority int
+
+const (
+	// SyncLoadLowPriority is for background warmups.
+	SyncLoadLowPriority SyncLoadPriority = iota
+	// SyncLoadHighPriority is for columns needed by a currently executing query.
+	SyncLoadHighPriority
+)
+
 type neededColumnMap struct {
 	m    sync.Mutex
-	cols map[tableColumnID]struct{}
+	cols map[tableColumnID]SyncLoadPriority
 }
 
+// AllCols returns the pending columns with high-priority entries first, so
+// that LoadNeededHistograms drains them before any background-warmup ones.
 func (n *neededColumnMap) AllCols() []tableColumnID {
 	n.m.Lock()
 	keys := make([]tableColumnID, 0, len(n.cols))
@@ -144,12 +160,27 @@ func (n *neededColumnMap) AllCols() []tableColumnID {
 		keys = append(keys, key)
 	}
 	n.m.Unlock()
+	sort.Slice(keys, func(i, j int) bool {
+		return n.cols[keys[i]] > n.cols[keys[j]]
+	})
 	return keys
 }
 
-func (n *neededColumnMap) insert(col tableColumnID) {
+// Len returns the number of columns pending a histogram load, used to
+// expose stats sync-load queue depth as a metric.
+func (n *neededColumnMap) Len() int {
 	n.m.Lock()
-	n.cols[col] = struct{}{}
+	defer n.m.Unlock()
+	return len(n.cols)
+}
+
+func (n *neededColumnMap) insert(col tableColumnID, priority SyncLoadPriority) {
+	n.m.Lock()
+	// A column already queued at high priority must not be downgraded by a
+	// later low-priority (background) request for the same column.
+	if existing, ok := n.cols[col]; !ok || priority > existing {
+		n.cols[col] = priority
+	}
 	n.m.Unlock()
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tidb/store/tikv/oracle"
 	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 	tidbutil "github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/gcutil"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
 )
@@ -384,6 +385,21 @@ func (w *GCWorker) calculateNewSafePoint(now time.Time) (*time.Time, error) {
 		return nil, errors.Trace(err)
 	}
 	safePoint := now.Add(-*lifeTime)
+	// A session reading a pinned snapshot (see gcutil.PinGCSafePoint, used
+	// by BEGIN SNAPSHOT FOR DUMP-style logical dumps) needs the safe point
+	// to not advance past its snapshot ts for as long as the pin is held,
+	// so a multi-connection dump doesn't have rows it still needs GC'd out
+	// from under it. The pin is stored in mysql.tidb rather than only in
+	// the pinning instance's memory, so it's visible here regardless of
+	// which TiDB instance registered it or which instance is currently the
+	// GC leader running this tick.
+	if pinnedTS, ok, err := gcutil.MinGCSafePointPin(w.session); err != nil {
+		return nil, errors.Trace(err)
+	} else if ok {
+		if pinnedSafePoint := oracle.GetTimeFromTS(pinnedTS); pinnedSafePoint.Before(safePoint) {
+			safePoint = pinnedSafePoint
+		}
+	}
 	// We should never decrease safePoint.
 	if lastSafePoint != nil && safePoint.Before(*lastSafePoint) {
 		logutil.Logger(context.Background()).Info("[gc worker] last safe point is later than current one."+
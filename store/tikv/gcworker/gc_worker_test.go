@@ -28,6 +28,8 @@ import (
 	"github.com/pingcap/tidb/store/mockoracle"
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/store/tikv/oracle"
+	"github.com/pingcap/tidb/util/gcutil"
 )
 
 func TestT(t *testing.T) {
@@ -239,3 +241,90 @@ func (s *testGCWorkerSuite) TestCheckGCMode(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(useDistributedGC, Equals, true)
 }
+
+// TestGCSafePointPinFromOtherSession proves that a GC safe point pin
+// registered through a session that isn't the GC worker's own -- standing
+// in for a pin registered on a different, non-leader TiDB instance -- is
+// still seen by calculateNewSafePoint, because gcutil.PinGCSafePoint stores
+// the pin in mysql.tidb rather than only in the pinning session's memory.
+func (s *testGCWorkerSuite) TestGCSafePointPinFromOtherSession(c *C) {
+	otherSession, err := session.CreateSession4Test(s.store)
+	c.Assert(err, IsNil)
+	defer otherSession.Close()
+
+	// Move the clock forward so the pinned ts below lands after the safe
+	// point already saved by SetUpTest's initial tick, which would
+	// otherwise trip the "never decrease safePoint" guard and mask the pin.
+	s.oracle.AddOffset(2 * time.Hour)
+	now, err := s.gcWorker.getOracleTime()
+	c.Assert(err, IsNil)
+	pinnedSafePoint := now.Add(-time.Hour)
+	pinnedTS := oracle.ComposeTS(oracle.GetPhysical(pinnedSafePoint), 0)
+
+	pinID, err := gcutil.PinGCSafePoint(otherSession, pinnedTS, time.Hour)
+	c.Assert(err, IsNil)
+
+	safePoint, err := s.gcWorker.calculateNewSafePoint(now)
+	c.Assert(err, IsNil)
+	c.Assert(safePoint, NotNil)
+	s.timeEqual(c, *safePoint, pinnedSafePoint, 2*time.Second)
+
+	err = gcutil.UnpinGCSafePoint(otherSession, pinID)
+	c.Assert(err, IsNil)
+
+	safePoint, err = s.gcWorker.calculateNewSafePoint(now)
+	c.Assert(err, IsNil)
+	c.Assert(safePoint, NotNil)
+	s.timeEqual(c, *safePoint, now.Add(-gcDefaultLifeTime), 2*time.Second)
+}
+
+// TestGCSafePointPinSharedConnectionID proves that two sessions pinning the
+// GC safe point don't collide even when they share the same ConnectionID --
+// which can legitimately happen across TiDB instances, since ConnectionID
+// is only unique within a single process (see server.baseConnID). Before
+// pins were keyed by a UUID instead of ConnectionID, the second session's
+// pin would silently overwrite the first's, and releasing either session's
+// pin would delete the one shared row out from under the other.
+func (s *testGCWorkerSuite) TestGCSafePointPinSharedConnectionID(c *C) {
+	session1, err := session.CreateSession4Test(s.store)
+	c.Assert(err, IsNil)
+	defer session1.Close()
+	session2, err := session.CreateSession4Test(s.store)
+	c.Assert(err, IsNil)
+	defer session2.Close()
+	session1.GetSessionVars().ConnectionID = 1
+	session2.GetSessionVars().ConnectionID = 1
+
+	s.oracle.AddOffset(2 * time.Hour)
+	now, err := s.gcWorker.getOracleTime()
+	c.Assert(err, IsNil)
+	earlierSafePoint := now.Add(-2 * time.Hour)
+	laterSafePoint := now.Add(-time.Hour)
+
+	pinID1, err := gcutil.PinGCSafePoint(session1, oracle.ComposeTS(oracle.GetPhysical(earlierSafePoint), 0), time.Hour)
+	c.Assert(err, IsNil)
+	pinID2, err := gcutil.PinGCSafePoint(session2, oracle.ComposeTS(oracle.GetPhysical(laterSafePoint), 0), time.Hour)
+	c.Assert(err, IsNil)
+
+	// Both pins must still be visible -- session2's pin must not have
+	// overwritten session1's -- so the minimum of the two wins.
+	safePoint, err := s.gcWorker.calculateNewSafePoint(now)
+	c.Assert(err, IsNil)
+	c.Assert(safePoint, NotNil)
+	s.timeEqual(c, *safePoint, earlierSafePoint, 2*time.Second)
+
+	// Releasing session1's pin must not also release session2's.
+	err = gcutil.UnpinGCSafePoint(session1, pinID1)
+	c.Assert(err, IsNil)
+	safePoint, err = s.gcWorker.calculateNewSafePoint(now)
+	c.Assert(err, IsNil)
+	c.Assert(safePoint, NotNil)
+	s.timeEqual(c, *safePoint, laterSafePoint, 2*time.Second)
+
+	err = gcutil.UnpinGCSafePoint(session2, pinID2)
+	c.Assert(err, IsNil)
+	safePoint, err = s.gcWorker.calculateNewSafePoint(now)
+	c.Assert(err, IsNil)
+	c.Assert(safePoint, NotNil)
+	s.timeEqual(c, *safePoint, now.Add(-gcDefaultLifeTime), 2*time.Second)
+}
@@ -75,6 +75,37 @@ func FindCol(cols []*Column, name string) *Column {
 	return nil
 }
 
+// OrderByDependency returns the generated columns among cols, ordered so that
+// a generated column always comes after every generated column it depends
+// on (a dependency may be declared earlier or later in cols). The caller is
+// responsible for having already verified the dependency graph has no cycle.
+func OrderByDependency(cols []*Column) []*Column {
+	var ordered []*Column
+	visited := make(map[string]bool, len(cols))
+	var visit func(col *Column)
+	visit = func(col *Column) {
+		if visited[col.Name.L] {
+			return
+		}
+		visited[col.Name.L] = true
+		for dep := range col.Dependences {
+			depCol := FindCol(cols, dep)
+			if depCol == nil || !depCol.IsGenerated() {
+				continue
+			}
+			visit(depCol)
+		}
+		ordered = append(ordered, col)
+	}
+	for _, col := range cols {
+		if !col.IsGenerated() {
+			continue
+		}
+		visit(col)
+	}
+	return ordered
+}
+
 // ToColumn converts a *model.ColumnInfo to *Column.
 func ToColumn(col *model.ColumnInfo) *Column {
 	return &Column{
@@ -15,6 +15,9 @@ package gcutil
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/parser/model"
@@ -22,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/twinj/uuid"
 )
 
 const (
@@ -29,8 +33,21 @@ const (
 	insertVariableValueSQL = `INSERT HIGH_PRIORITY INTO mysql.tidb VALUES ('%[1]s', '%[2]s', '%[3]s')
                               ON DUPLICATE KEY
 			                  UPDATE variable_value = '%[2]s', comment = '%[3]s'`
+	deleteVariableSQL = `DELETE HIGH_PRIORITY FROM mysql.tidb WHERE variable_name='%s'`
+
+	// gcSafePointPinKeyPrefix namespaces GC safe point pin rows (see
+	// PinGCSafePoint) within mysql.tidb, the same table gcSafePointKey and
+	// gcLeaderUUIDKey already live in, so every TiDB instance -- not just
+	// whichever one is holding the GC-leader election -- can register and
+	// see a pin.
+	gcSafePointPinKeyPrefix  = "tidb_gc_safe_point_pin_"
+	selectGCSafePointPinsSQL = `SELECT HIGH_PRIORITY variable_name, variable_value FROM mysql.tidb WHERE variable_name LIKE '` + gcSafePointPinKeyPrefix + `%'`
 )
 
+func gcSafePointPinKey(pinID string) string {
+	return gcSafePointPinKeyPrefix + pinID
+}
+
 // CheckGCEnable is use to check whether GC is enable.
 func CheckGCEnable(ctx sessionctx.Context) (enable bool, err error) {
 	sql := fmt.Sprintf(selectVariableValueSQL, "tikv_gc_enable")
@@ -96,3 +113,66 @@ func GetGCSafePoint(ctx sessionctx.Context) (uint64, error) {
 	ts := variable.GoTimeToTS(safePointTime)
 	return ts, nil
 }
+
+// PinGCSafePoint registers, cluster-wide, ts as a floor the GC safe point
+// must not advance past until ttl elapses, by writing a row into
+// mysql.tidb keyed by a freshly minted UUID (the same uuid.NewV4 pattern
+// ddl.newDDL uses for owner election), and returns that UUID so the caller
+// can later release the pin with UnpinGCSafePoint. The connection ID is
+// not cluster-unique -- it's a per-process counter (see server.baseConnID)
+// -- so two sessions on two different TiDB instances could otherwise be
+// assigned the same one and overwrite or delete each other's pin. Storing
+// the pin in mysql.tidb, rather than only in this instance's memory, is
+// what lets calculateNewSafePoint see the pin regardless of which TiDB
+// instance happens to be the current GC leader.
+func PinGCSafePoint(ctx sessionctx.Context, ts uint64, ttl time.Duration) (string, error) {
+	pinID := uuid.NewV4().String()
+	value := fmt.Sprintf("%d,%d", ts, time.Now().Add(ttl).Unix())
+	sql := fmt.Sprintf(insertVariableValueSQL, gcSafePointPinKey(pinID), value, "GC safe point pin, see gcutil.PinGCSafePoint (DO NOT EDIT)")
+	_, _, err := ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, sql)
+	return pinID, errors.Trace(err)
+}
+
+// UnpinGCSafePoint releases the GC safe point pin registered under pinID,
+// the UUID PinGCSafePoint returned when it was created. It's a no-op if
+// that pin doesn't exist, e.g. it already expired.
+func UnpinGCSafePoint(ctx sessionctx.Context, pinID string) error {
+	sql := fmt.Sprintf(deleteVariableSQL, gcSafePointPinKey(pinID))
+	_, _, err := ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, sql)
+	return errors.Trace(err)
+}
+
+// MinGCSafePointPin returns the smallest ts among all active, unexpired GC
+// safe point pins registered cluster-wide by PinGCSafePoint, and whether
+// any such pin exists. Expired pins are deleted as a side effect.
+func MinGCSafePointPin(ctx sessionctx.Context) (ts uint64, ok bool, err error) {
+	rows, _, err := ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, selectGCSafePointPinsSQL)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	now := time.Now().Unix()
+	for _, row := range rows {
+		name := row.GetString(0)
+		parts := strings.SplitN(row.GetString(1), ",", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		pinTS, err1 := strconv.ParseUint(parts[0], 10, 64)
+		expire, err2 := strconv.ParseInt(parts[1], 10, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		if now >= expire {
+			delSQL := fmt.Sprintf(deleteVariableSQL, name)
+			if _, _, delErr := ctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedSQL(ctx, delSQL); delErr != nil {
+				return 0, false, errors.Trace(delErr)
+			}
+			continue
+		}
+		if !ok || pinTS < ts {
+			ts = pinTS
+			ok = true
+		}
+	}
+	return ts, ok, nil
+}
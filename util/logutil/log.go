@@ -314,7 +314,16 @@ func InitLogger(cfg *LogConfig) error {
 		if err := initFileLog(&tmp, SlowQueryLogger); err != nil {
 			return errors.Trace(err)
 		}
-		SlowQueryLogger.Formatter = &slowLogFormatter{}
+		// The slow log entry is pre-formatted into a single multi-line
+		// "# Key: Value" message (see SessionVars.SlowLogFormat), so unlike
+		// the general server log, "text" here means that pre-formatted
+		// message written as-is. "json" wraps it as a JSON log line instead,
+		// for log shippers that expect one JSON object per line.
+		if strings.ToLower(cfg.Format) == "json" {
+			SlowQueryLogger.Formatter = stringToLogFormatter(cfg.Format, cfg.DisableTimestamp)
+		} else {
+			SlowQueryLogger.Formatter = &slowLogFormatter{}
+		}
 	}
 
 	return nil
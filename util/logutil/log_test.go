@@ -120,6 +120,25 @@ func (s *testLogSuite) TestSlowQueryLogger(c *C) {
 	c.Assert(err, Equals, io.EOF)
 }
 
+func (s *testLogSuite) TestSlowQueryLoggerJSONFormat(c *C) {
+	fileName := "slow_query_json"
+	conf := NewLogConfig("info", "json", fileName, EmptyFileLogConfig, false)
+	err := InitLogger(conf)
+	c.Assert(err, IsNil)
+	defer os.Remove(fileName)
+
+	SlowQueryLogger.Warn("# Txn_start_ts: 1\nselect * from t;")
+
+	f, err := os.Open(fileName)
+	c.Assert(err, IsNil)
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	line, err := r.ReadString('\n')
+	c.Assert(err, IsNil)
+	c.Assert(line, Matches, `\{.*"msg":"# Txn_start_ts: 1\\nselect \* from t;".*\}\n`)
+}
+
 func (s *testLogSuite) TestLoggerKeepOrder(c *C) {
 	conf := NewLogConfig("warn", DefaultLogFormat, "", EmptyFileLogConfig, true)
 	c.Assert(InitLogger(conf), IsNil)
@@ -179,6 +179,45 @@ func (t *Tracker) String() string {
 	return buffer.String()
 }
 
+// TrackerSnapshot is one row of a flattened Tracker tree, as produced by
+// Snapshot, for callers that need the hierarchy as data rather than as the
+// pre-formatted text String returns.
+type TrackerSnapshot struct {
+	Label         string
+	ParentLabel   string // empty for the root tracker passed to Snapshot.
+	BytesConsumed int64
+	BytesLimit    int64
+}
+
+// Snapshot flattens this tracker and its descendants, in pre-order, into
+// TrackerSnapshot rows.
+func (t *Tracker) Snapshot() []TrackerSnapshot {
+	var rows []TrackerSnapshot
+	t.appendSnapshot("", &rows)
+	return rows
+}
+
+func (t *Tracker) appendSnapshot(parentLabel string, rows *[]TrackerSnapshot) {
+	label := fmt.Sprintf("%s", t.label)
+	*rows = append(*rows, TrackerSnapshot{
+		Label:         label,
+		ParentLabel:   parentLabel,
+		BytesConsumed: t.BytesConsumed(),
+		BytesLimit:    t.bytesLimit,
+	})
+
+	t.mu.Lock()
+	children := make([]*Tracker, len(t.mu.children))
+	copy(children, t.mu.children)
+	t.mu.Unlock()
+
+	for _, child := range children {
+		if child != nil {
+			child.appendSnapshot(label, rows)
+		}
+	}
+}
+
 func (t *Tracker) toString(indent string, buffer *bytes.Buffer) {
 	fmt.Fprintf(buffer, "%s\"%s\"{\n", indent, t.label)
 	if t.bytesLimit > 0 {
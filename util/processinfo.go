@@ -18,19 +18,41 @@ import (
 	"time"
 
 	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/util/memory"
 )
 
 // ProcessInfo is a struct used for show processlist statement.
 type ProcessInfo struct {
-	ID      uint64
-	User    string
-	Host    string
-	DB      string
-	Command byte
-	Plan    interface{}
-	Time    time.Time
-	State   uint16
-	Info    string
+	ID         uint64
+	User       string
+	Host       string
+	DB         string
+	Command    byte
+	Plan       interface{}
+	Time       time.Time
+	State      uint16
+	Info       string
+	MemTracker *memory.Tracker
+
+	// CurTxnStartTS is the start_ts of this session's current transaction, if
+	// any; 0 means no transaction is open. CurTxnStartTime is when that
+	// transaction began. Together they let a GC-safepoint blocker listing
+	// report which open transactions are holding the safepoint back and for
+	// how long.
+	CurTxnStartTS   uint64
+	CurTxnStartTime time.Time
+
+	// StmtCtx is the current statement's StatementContext. It's the live
+	// object the running statement is mutating, not a snapshot, so
+	// StmtCtx.AffectedRows() reflects rows written so far even while a large
+	// DML is still executing. CurTxnMemBuffer is likewise the current
+	// transaction's live MemBuffer, if a transaction is open; its Size()
+	// grows as the transaction accumulates mutations. Together they let a
+	// long-running, large DML's progress be estimated before it finishes.
+	StmtCtx         *stmtctx.StatementContext
+	CurTxnMemBuffer kv.MemBuffer
 }
 
 // ToRow returns []interface{} for the row data of "show processlist" and "select * from infoschema.processlist".